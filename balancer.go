@@ -0,0 +1,300 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A load balancer picking backends by smooth weighted round-robin.
+//
+// Backends live in a CircularDoublyLinkedList. Every call to Next adds each
+// healthy backend's weight to its running score, picks the backend with the
+// highest score and subtracts the total weight from it, so that a backend of
+// weight 3 among weights 3, 1 and 1 is picked three times out of five, spread
+// out rather than in a burst. Ties go to the first backend after the previous
+// pick, so equal weights give plain round-robin.
+//
+// Backends can be added, removed and marked unhealthy at any time without
+// disturbing the rotation: the others keep their scores and their position.
+// All methods are safe for concurrent use.
+type Balancer[T comparable] struct {
+	mu    sync.Mutex
+	ring  *CircularDoublyLinkedList[*backend[T]]
+	nodes map[T]*DoublyLinkedNode[*backend[T]]
+	// Where the next scan starts: the node after the previous pick.
+	cursor *DoublyLinkedNode[*backend[T]]
+}
+
+// A backend with its smooth weighted round-robin state.
+type backend[T comparable] struct {
+	value   T
+	weight  int
+	current int
+	healthy bool
+}
+
+// Creates an empty balancer.
+//
+// Returns:
+//   - *Balancer[T]: Pointer to the new balancer.
+//
+// Example:
+//
+//	lb := list.NewBalancer[string]()
+//	lb.Add("10.0.0.1:80", 3)
+//	lb.Add("10.0.0.2:80", 1)
+func NewBalancer[T comparable]() *Balancer[T] {
+	return &Balancer[T]{
+		ring:  NewCircularDoublyLinkedList[*backend[T]](),
+		nodes: make(map[T]*DoublyLinkedNode[*backend[T]]),
+	}
+}
+
+// Adds a healthy backend at the end of the rotation, or updates the weight
+// of an existing one.
+//
+// Parameters:
+//   - value: The backend.
+//   - weight: Its relative share of the picks. Must be positive.
+//
+// Returns:
+//   - error: An error wrapping ErrInvalidWeight if weight is not positive.
+//
+// Example:
+//
+//	if err := lb.Add("10.0.0.3:80", 2); err != nil {
+//	    return err
+//	}
+func (b *Balancer[T]) Add(value T, weight int) error {
+	if weight < 1 {
+		return fmt.Errorf("%w: %v has weight %d", ErrInvalidWeight, value, weight)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if node, ok := b.nodes[value]; ok {
+		node.Value().weight = weight
+		return nil
+	}
+	b.ring.Append(&backend[T]{value: value, weight: weight, healthy: true})
+	b.nodes[value] = b.ring.Tail()
+	return nil
+}
+
+// Removes a backend. The rotation of the others continues where it was.
+//
+// Parameters:
+//   - value: The backend.
+//
+// Returns:
+//   - bool: True if the backend was present.
+//
+// Example:
+//
+//	lb.Remove("10.0.0.2:80")
+func (b *Balancer[T]) Remove(value T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, ok := b.nodes[value]
+	if !ok {
+		return false
+	}
+	if node == b.cursor {
+		b.cursor = node.Next()
+	}
+	b.ring.unlink(node)
+	delete(b.nodes, value)
+	if b.ring.IsEmpty() {
+		b.cursor = nil
+	}
+	return true
+}
+
+// Marks a backend healthy or unhealthy. Unhealthy backends are skipped by
+// Next until marked healthy again, and restart from a score of zero when they
+// change state; the scores of the others are left alone.
+//
+// Parameters:
+//   - value: The backend.
+//   - healthy: Whether the backend may be picked.
+//
+// Returns:
+//   - bool: True if the backend is known.
+//
+// Example:
+//
+//	lb.SetHealthy("10.0.0.1:80", false)
+func (b *Balancer[T]) SetHealthy(value T, healthy bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, ok := b.nodes[value]
+	if !ok {
+		return false
+	}
+	if backend := node.Value(); backend.healthy != healthy {
+		backend.healthy = healthy
+		backend.current = 0
+	}
+	return true
+}
+
+// Picks the next backend.
+//
+// Returns:
+//   - T: The picked backend.
+//   - bool: False if there is no healthy backend.
+//
+// Example:
+//
+//	addr, ok := lb.Next()
+//	if !ok {
+//	    return errNoBackend
+//	}
+func (b *Balancer[T]) Next() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node := b.cursor
+	if node == nil {
+		node = b.ring.Head()
+	}
+	var best *backend[T]
+	var bestNode *DoublyLinkedNode[*backend[T]]
+	total := 0
+	for range b.ring.Size() {
+		if candidate := node.Value(); candidate.healthy {
+			candidate.current += candidate.weight
+			total += candidate.weight
+			if best == nil || candidate.current > best.current {
+				best, bestNode = candidate, node
+			}
+		}
+		node = node.Next()
+	}
+	if best == nil {
+		var zero T
+		return zero, false
+	}
+	best.current -= total
+	b.cursor = bestNode.Next()
+	return best.value, true
+}
+
+// Returns the number of backends, healthy or not.
+//
+// Returns:
+//   - int: Number of backends.
+//
+// Example:
+//
+//	fmt.Println(lb.Len())
+func (b *Balancer[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.ring.Size()
+}
+
+// Returns the backends in rotation order.
+//
+// Returns:
+//   - []T: The backends, healthy or not.
+//
+// Example:
+//
+//	fmt.Println(lb.Backends())
+func (b *Balancer[T]) Backends() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	result := make([]T, 0, b.ring.Size())
+	for backend := range b.ring.values() {
+		result = append(result, backend.value)
+	}
+	return result
+}
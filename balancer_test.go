@@ -0,0 +1,158 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func picks(b *Balancer[string], n int) []string {
+	result := make([]string, 0, n)
+	for range n {
+		value, _ := b.Next()
+		result = append(result, value)
+	}
+	return result
+}
+
+func TestBalancerSmoothWeighted(t *testing.T) {
+	b := NewBalancer[string]()
+	b.Add("a", 5)
+	b.Add("b", 1)
+	b.Add("c", 1)
+	got := picks(b, 7)
+	if expected := []string{"a", "a", "b", "a", "c", "a", "a"}; !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	counts := map[string]int{}
+	for _, value := range picks(b, 700) {
+		counts[value]++
+	}
+	if counts["a"] != 500 || counts["b"] != 100 || counts["c"] != 100 {
+		t.Errorf("expected a 5:1:1 split, got %v", counts)
+	}
+}
+
+func TestBalancerEqualWeightsRotate(t *testing.T) {
+	b := NewBalancer[string]()
+	for _, value := range []string{"a", "b", "c"} {
+		b.Add(value, 1)
+	}
+	if got := picks(b, 6); !slices.Equal(got, []string{"a", "b", "c", "a", "b", "c"}) {
+		t.Errorf("expected plain round-robin, got %v", got)
+	}
+}
+
+func TestBalancerDynamicChanges(t *testing.T) {
+	b := NewBalancer[string]()
+	for _, value := range []string{"a", "b", "c"} {
+		b.Add(value, 1)
+	}
+	b.Next()
+	b.Remove("b")
+	// a keeps the debt of its pick, so c is served until it is repaid.
+	if got := picks(b, 2); !slices.Equal(got, []string{"c", "c"}) {
+		t.Errorf("expected c to be served while a repays its pick, got %v", got)
+	}
+	b.Add("d", 1)
+	if got := picks(b, 3); !slices.Equal(got, []string{"a", "d", "c"}) {
+		t.Errorf("expected d to join the rotation, got %v", got)
+	}
+	if !slices.Equal(b.Backends(), []string{"a", "c", "d"}) || b.Len() != 3 {
+		t.Errorf("unexpected backends %v", b.Backends())
+	}
+	if b.Remove("missing") {
+		t.Error("expected Remove of an unknown backend to fail")
+	}
+	b.Remove("a")
+	b.Remove("c")
+	b.Remove("d")
+	if _, ok := b.Next(); ok {
+		t.Error("expected no pick from an empty balancer")
+	}
+}
+
+func TestBalancerRemoveKeepsWeightedOrder(t *testing.T) {
+	b := NewBalancer[string]()
+	b.Add("a", 3)
+	b.Add("b", 1)
+	b.Add("c", 1)
+	if got := picks(b, 3); !slices.Equal(got, []string{"a", "b", "a"}) {
+		t.Fatalf("expected [a b a], got %v", got)
+	}
+	b.Remove("b")
+	// c was due next and keeps its turn, then a and c go on at 3 to 1;
+	// restarting the scores would pick a first instead.
+	if got := picks(b, 4); !slices.Equal(got, []string{"c", "a", "a", "c"}) {
+		t.Errorf("expected the rotation to continue with [c a a c], got %v", got)
+	}
+}
+
+func TestBalancerUnhealthyBackendsAreSkipped(t *testing.T) {
+	b := NewBalancer[string]()
+	b.Add("a", 1)
+	b.Add("b", 1)
+	b.SetHealthy("a", false)
+	if got := picks(b, 3); !slices.Equal(got, []string{"b", "b", "b"}) {
+		t.Errorf("expected only b, got %v", got)
+	}
+	b.SetHealthy("b", false)
+	if _, ok := b.Next(); ok {
+		t.Error("expected no pick with every backend unhealthy")
+	}
+	b.SetHealthy("a", true)
+	if got := picks(b, 2); !slices.Equal(got, []string{"a", "a"}) {
+		t.Errorf("expected only a, got %v", got)
+	}
+	if b.SetHealthy("missing", true) {
+		t.Error("expected SetHealthy of an unknown backend to fail")
+	}
+}
+
+func TestBalancerInvalidWeight(t *testing.T) {
+	b := NewBalancer[string]()
+	if err := b.Add("a", 0); !errors.Is(err, ErrInvalidWeight) {
+		t.Errorf("expected ErrInvalidWeight, got %v", err)
+	}
+	b.Add("a", 1)
+	b.Add("b", 1)
+	b.Add("a", 3)
+	counts := map[string]int{}
+	for _, value := range picks(b, 40) {
+		counts[value]++
+	}
+	if counts["a"] != 30 {
+		t.Errorf("expected Add to update the weight of a, got %v", counts)
+	}
+}
+
+func TestBalancerConcurrentNext(t *testing.T) {
+	b := NewBalancer[int]()
+	for i := range 4 {
+		b.Add(i, i+1)
+	}
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		counts = map[int]int{}
+	)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 250 {
+				value, _ := b.Next()
+				mu.Lock()
+				counts[value]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range 4 {
+		if counts[i] != 200*(i+1) {
+			t.Errorf("expected %d picks of %d, got %d", 200*(i+1), i, counts[i])
+		}
+	}
+}
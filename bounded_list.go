@@ -0,0 +1,454 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Decides what a BoundedList does with a value that does not fit.
+type OverflowPolicy int
+
+const (
+	// Refuses the value with ErrCapacityExceeded.
+	OverflowReject OverflowPolicy = iota
+	// Evicts elements from the head until the value fits.
+	OverflowEvictHead
+	// Evicts elements from the tail until the value fits.
+	OverflowEvictTail
+	// Waits until other goroutines remove enough elements.
+	OverflowBlock
+)
+
+// Configures the capacity of a BoundedList.
+type Capacity[T comparable] struct {
+	// Maximum total cost of the elements. Must be positive.
+	Limit int
+	// What to do when a value does not fit.
+	Policy OverflowPolicy
+	// Returns the non-negative cost of a value. It is called once per
+	// insertion and the result is kept with the element, so later changes to
+	// the value do not affect the accounting. When nil every element costs
+	// 1, so Limit is a maximum number of elements.
+	Cost func(T) int
+	// Called with every evicted value, after the insertion that caused the
+	// eviction has completed. Optional.
+	OnEvict func(T)
+}
+
+// A SinglyLinkedList or DoublyLinkedList whose contents are limited by a
+// Capacity.
+//
+// All methods are safe for concurrent use, which the OverflowBlock policy
+// relies on: a blocked insertion resumes when another goroutine removes
+// elements.
+//
+// Example:
+//
+//	recent := list.NewBoundedList[*list.DoublyLinkedList[string]](list.Capacity[string]{
+//	    Limit:  1 << 20,
+//	    Policy: list.OverflowEvictHead,
+//	    Cost:   func(s string) int { return len(s) },
+//	})
+type BoundedList[L LinearList[L, T], T comparable] struct {
+	mu       sync.Mutex
+	list     L
+	capacity Capacity[T]
+	// The cost of each element, recorded at insertion, in list order.
+	costs *DoublyLinkedList[int]
+	cost  int
+	// Closed and replaced whenever room is freed, waking blocked insertions.
+	freed chan struct{}
+}
+
+// Creates an empty bounded list of kind L.
+//
+// Parameters:
+//   - capacity: The limit, overflow policy, cost function and eviction
+//     callback.
+//
+// Returns:
+//   - *BoundedList[L, T]: Pointer to the new list.
+//
+// Example:
+//
+//	buffer := list.NewBoundedList[*list.SinglyLinkedList[int]](list.Capacity[int]{Limit: 100})
+func NewBoundedList[L LinearList[L, T], T comparable](capacity Capacity[T]) *BoundedList[L, T] {
+	if capacity.Limit < 1 {
+		panic("list: capacity limit must be positive")
+	}
+	var zero L
+	return &BoundedList[L, T]{
+		list:     zero.newLike(),
+		capacity: capacity,
+		costs:    NewDoublyLinkedList[int](),
+		freed:    make(chan struct{}),
+	}
+}
+
+// Appends a value at the end of the list, applying the overflow policy if it
+// does not fit. With OverflowBlock it waits without a deadline; use
+// AppendContext to bound the wait.
+//
+// Parameters:
+//   - value: The value to append.
+//
+// Returns:
+//   - error: ErrCapacityExceeded if the value was refused, or an error
+//     wrapping ErrInvalidCost if its cost is negative.
+//
+// Example:
+//
+//	if err := buffer.Append(42); err != nil {
+//	    log.Println(err)
+//	}
+func (b *BoundedList[L, T]) Append(value T) error {
+	return b.insert(context.Background(), value, false)
+}
+
+// Appends a value at the end of the list like Append, giving up when ctx is
+// done while blocked.
+//
+// Parameters:
+//   - ctx: Bounds the wait of the OverflowBlock policy.
+//   - value: The value to append.
+//
+// Returns:
+//   - error: ErrCapacityExceeded if the value was refused, an error wrapping
+//     ErrInvalidCost if its cost is negative, or ctx.Err().
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	err := buffer.AppendContext(ctx, 42)
+func (b *BoundedList[L, T]) AppendContext(ctx context.Context, value T) error {
+	return b.insert(ctx, value, false)
+}
+
+// Inserts a value at the beginning of the list, applying the overflow policy
+// if it does not fit.
+//
+// Parameters:
+//   - value: The value to insert.
+//
+// Returns:
+//   - error: ErrCapacityExceeded if the value was refused, or an error
+//     wrapping ErrInvalidCost if its cost is negative.
+//
+// Example:
+//
+//	err := buffer.Prepend(0)
+func (b *BoundedList[L, T]) Prepend(value T) error {
+	return b.insert(context.Background(), value, true)
+}
+
+// Inserts a value at the beginning of the list like Prepend, giving up when
+// ctx is done while blocked.
+//
+// Parameters:
+//   - ctx: Bounds the wait of the OverflowBlock policy.
+//   - value: The value to insert.
+//
+// Returns:
+//   - error: ErrCapacityExceeded if the value was refused, an error wrapping
+//     ErrInvalidCost if its cost is negative, or ctx.Err().
+//
+// Example:
+//
+//	err := buffer.PrependContext(ctx, 0)
+func (b *BoundedList[L, T]) PrependContext(ctx context.Context, value T) error {
+	return b.insert(ctx, value, true)
+}
+
+// Removes and returns the first element.
+//
+// Returns:
+//   - T: The removed value.
+//   - bool: False if the list was empty.
+//
+// Example:
+//
+//	if value, ok := buffer.RemoveFirst(); ok {
+//	    fmt.Println(value)
+//	}
+func (b *BoundedList[L, T]) RemoveFirst() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.list.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	value := b.take(true)
+	b.signal()
+	return value, true
+}
+
+// Removes and returns the last element.
+//
+// Returns:
+//   - T: The removed value.
+//   - bool: False if the list was empty.
+//
+// Example:
+//
+//	if value, ok := buffer.RemoveLast(); ok {
+//	    fmt.Println(value)
+//	}
+func (b *BoundedList[L, T]) RemoveLast() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.list.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	value := b.take(false)
+	b.signal()
+	return value, true
+}
+
+// Removes every element. The eviction callback is not called.
+//
+// Example:
+//
+//	buffer.Clear()
+func (b *BoundedList[L, T]) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.list.Clear()
+	b.costs.Clear()
+	b.cost = 0
+	b.signal()
+}
+
+// Returns the number of elements in the list.
+//
+// Returns:
+//   - int: Number of elements.
+//
+// Example:
+//
+//	fmt.Println(buffer.Size())
+func (b *BoundedList[L, T]) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.Size()
+}
+
+// Returns the total cost of the elements, which never exceeds the limit.
+//
+// Returns:
+//   - int: The sum of the costs of the elements.
+//
+// Example:
+//
+//	fmt.Printf("%d/%d bytes\n", buffer.Cost(), limit)
+func (b *BoundedList[L, T]) Cost() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.cost
+}
+
+// Returns a slice containing the elements of the list.
+//
+// Returns:
+//   - []T: Slice of the elements, from head to tail.
+//
+// Example:
+//
+//	values := buffer.ToSlice()
+func (b *BoundedList[L, T]) ToSlice() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	result := make([]T, 0, b.list.Size())
+	for value := range b.list.values() {
+		result = append(result, value)
+	}
+	return result
+}
+
+// Returns the representation of the underlying list.
+//
+// Returns:
+//   - string: The formatted list.
+//
+// Example:
+//
+//	fmt.Println(buffer)
+func (b *BoundedList[L, T]) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.String()
+}
+
+// Makes room for value according to the policy, then links it at the front
+// or the back. Evicted values are reported once the lock is released.
+func (b *BoundedList[L, T]) insert(ctx context.Context, value T, front bool) error {
+	cost := b.costOf(value)
+	if cost < 0 {
+		return fmt.Errorf("%w: %v costs %d", ErrInvalidCost, value, cost)
+	}
+	if cost > b.capacity.Limit {
+		return ErrCapacityExceeded
+	}
+	var evicted []T
+	b.mu.Lock()
+makeRoom:
+	for b.cost+cost > b.capacity.Limit {
+		switch b.capacity.Policy {
+		case OverflowEvictHead, OverflowEvictTail:
+			if b.list.IsEmpty() {
+				break makeRoom
+			}
+			evicted = append(evicted, b.take(b.capacity.Policy == OverflowEvictHead))
+		case OverflowBlock:
+			freed := b.freed
+			b.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-freed:
+			}
+			b.mu.Lock()
+		default:
+			b.mu.Unlock()
+			return ErrCapacityExceeded
+		}
+	}
+	if front {
+		b.list.Prepend(value)
+		b.costs.Prepend(cost)
+	} else {
+		b.list.Append(value)
+		b.costs.Append(cost)
+	}
+	b.cost += cost
+	b.mu.Unlock()
+	if b.capacity.OnEvict != nil {
+		for _, value := range evicted {
+			b.capacity.OnEvict(value)
+		}
+	}
+	return nil
+}
+
+// Removes the first or the last element along with its recorded cost and
+// returns it. The caller holds the lock, and the list must not be empty.
+func (b *BoundedList[L, T]) take(front bool) T {
+	var value T
+	if front {
+		value = b.list.first()
+		b.cost -= b.costs.Head().Value()
+		b.list.RemoveFirst()
+		b.costs.RemoveFirst()
+	} else {
+		value = b.list.last()
+		b.cost -= b.costs.Tail().Value()
+		b.list.RemoveLast()
+		b.costs.RemoveLast()
+	}
+	return value
+}
+
+// Wakes every blocked insertion. The caller holds the lock.
+func (b *BoundedList[L, T]) signal() {
+	close(b.freed)
+	b.freed = make(chan struct{})
+}
+
+// Returns the cost of value under the configured cost function.
+func (b *BoundedList[L, T]) costOf(value T) int {
+	if b.capacity.Cost == nil {
+		return 1
+	}
+	return b.capacity.Cost(value)
+}
@@ -0,0 +1,164 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBoundedListReject(t *testing.T) {
+	b := NewBoundedList[*DoublyLinkedList[int]](Capacity[int]{Limit: 2})
+	b.Append(1)
+	b.Prepend(0)
+	if err := b.Append(2); !errors.Is(err, ErrCapacityExceeded) {
+		t.Errorf("expected ErrCapacityExceeded, got %v", err)
+	}
+	if !slices.Equal(b.ToSlice(), []int{0, 1}) {
+		t.Errorf("expected [0 1], got %v", b.ToSlice())
+	}
+	b.RemoveLast()
+	if err := b.Append(2); err != nil {
+		t.Errorf("expected room after a removal, got %v", err)
+	}
+}
+
+func TestBoundedListEvictHead(t *testing.T) {
+	var evicted []int
+	b := NewBoundedList[*SinglyLinkedList[int]](Capacity[int]{
+		Limit:   3,
+		Policy:  OverflowEvictHead,
+		OnEvict: func(v int) { evicted = append(evicted, v) },
+	})
+	for i := range 6 {
+		b.Append(i)
+	}
+	if !slices.Equal(b.ToSlice(), []int{3, 4, 5}) {
+		t.Errorf("expected [3 4 5], got %v", b.ToSlice())
+	}
+	if !slices.Equal(evicted, []int{0, 1, 2}) {
+		t.Errorf("expected evictions [0 1 2], got %v", evicted)
+	}
+}
+
+func TestBoundedListEvictTail(t *testing.T) {
+	var evicted []int
+	b := NewBoundedList[*DoublyLinkedList[int]](Capacity[int]{
+		Limit:   2,
+		Policy:  OverflowEvictTail,
+		OnEvict: func(v int) { evicted = append(evicted, v) },
+	})
+	b.Prepend(1)
+	b.Prepend(2)
+	b.Prepend(3)
+	if !slices.Equal(b.ToSlice(), []int{3, 2}) {
+		t.Errorf("expected [3 2], got %v", b.ToSlice())
+	}
+	if !slices.Equal(evicted, []int{1}) {
+		t.Errorf("expected evictions [1], got %v", evicted)
+	}
+}
+
+func TestBoundedListCost(t *testing.T) {
+	b := NewBoundedList[*DoublyLinkedList[string]](Capacity[string]{
+		Limit:  10,
+		Policy: OverflowEvictHead,
+		Cost:   func(s string) int { return len(s) },
+	})
+	b.Append("abcd")
+	b.Append("efgh")
+	b.Append("ij")
+	if b.Cost() != 10 || b.Size() != 3 {
+		t.Fatalf("expected cost 10 over 3 elements, got %d over %d", b.Cost(), b.Size())
+	}
+	b.Append("klmnop")
+	if !slices.Equal(b.ToSlice(), []string{"ij", "klmnop"}) || b.Cost() != 8 {
+		t.Errorf("expected [ij klmnop] with cost 8, got %v with cost %d", b.ToSlice(), b.Cost())
+	}
+	if err := b.Append("way too long"); !errors.Is(err, ErrCapacityExceeded) {
+		t.Errorf("expected a value above the limit to be refused, got %v", err)
+	}
+	if b.Size() != 2 {
+		t.Errorf("expected a refused value to evict nothing, got %v", b.ToSlice())
+	}
+	b.Clear()
+	if b.Cost() != 0 || b.Size() != 0 {
+		t.Errorf("expected an empty list, got %v", b)
+	}
+}
+
+func TestBoundedListBlock(t *testing.T) {
+	b := NewBoundedList[*DoublyLinkedList[int]](Capacity[int]{Limit: 1, Policy: OverflowBlock})
+	b.Append(1)
+	done := make(chan error)
+	go func() {
+		done <- b.Append(2)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected Append to block, returned %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if value, ok := b.RemoveFirst(); !ok || value != 1 {
+		t.Fatalf("expected to remove 1, got %d, %v", value, ok)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(b.ToSlice(), []int{2}) {
+		t.Errorf("expected [2], got %v", b.ToSlice())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.PrependContext(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestNewBoundedListPanicsOnInvalidLimit(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected NewBoundedList to panic for limit 0")
+		}
+	}()
+	NewBoundedList[*SinglyLinkedList[int]](Capacity[int]{})
+}
+
+func TestBoundedListCostIsRecordedAtInsertion(t *testing.T) {
+	type item struct{ size int }
+	b := NewBoundedList[*DoublyLinkedList[*item]](Capacity[*item]{
+		Limit:  10,
+		Policy: OverflowEvictHead,
+		Cost:   func(i *item) int { return i.size },
+	})
+	first, second := &item{size: 4}, &item{size: 4}
+	b.Append(first)
+	b.Append(second)
+	first.size, second.size = 100, 0
+	if err := b.Append(&item{size: 6}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.Size() != 2 || b.Cost() != 10 {
+		t.Errorf("expected one eviction using the recorded cost, got size %d and cost %d", b.Size(), b.Cost())
+	}
+	b.RemoveFirst()
+	b.RemoveLast()
+	if b.Cost() != 0 {
+		t.Errorf("expected the cost to return to 0, got %d", b.Cost())
+	}
+}
+
+func TestBoundedListRejectsNegativeCost(t *testing.T) {
+	b := NewBoundedList[*SinglyLinkedList[int]](Capacity[int]{
+		Limit: 5,
+		Cost:  func(v int) int { return v },
+	})
+	if err := b.Append(-1); !errors.Is(err, ErrInvalidCost) {
+		t.Errorf("expected ErrInvalidCost, got %v", err)
+	}
+	if b.Size() != 0 || b.Cost() != 0 {
+		t.Errorf("expected the value to be refused, got %v with cost %d", b.ToSlice(), b.Cost())
+	}
+}
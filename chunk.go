@@ -0,0 +1,222 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "iter"
+
+// Splits a list into consecutive sub-lists of n elements; the last one may be
+// shorter.
+//
+// The input is left unchanged.
+//
+// Parameters:
+//   - l: The list to split, of any kind.
+//   - n: The size of each chunk. Chunk panics if n is less than 1.
+//
+// Returns:
+//   - []*DoublyLinkedList[T]: The chunks, in order.
+//
+// Example:
+//
+//	batches := list.Chunk(jobs, 100)
+func Chunk[L AnyList[T], T comparable](l L, n int) []*DoublyLinkedList[T] {
+	if n < 1 {
+		panic("list: chunk size cannot be less than 1")
+	}
+	chunks := make([]*DoublyLinkedList[T], 0, (l.Size()+n-1)/n)
+	for chunk := range ChunkSeq(l.values(), n) {
+		chunks = append(chunks, DoublyLinkedListFromSlice(chunk))
+	}
+	return chunks
+}
+
+// Returns an iterator over consecutive chunks of n elements of a sequence; the
+// last one may be shorter.
+//
+// Each yielded slice is freshly allocated and may be retained.
+//
+// Parameters:
+//   - seq: The sequence to split.
+//   - n: The size of each chunk. ChunkSeq panics if n is less than 1.
+//
+// Returns:
+//   - iter.Seq[[]T]: An iterator over the chunks.
+//
+// Example:
+//
+//	for batch := range list.ChunkSeq(jobs.All(), 100) {
+//	    process(batch)
+//	}
+func ChunkSeq[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("list: chunk size cannot be less than 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		for value := range seq {
+			chunk = append(chunk, value)
+			if len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Returns an iterator over every window of n consecutive elements of a list,
+// sliding one element at a time.
+//
+// See WindowsSeq for how the yielded slices may be used.
+//
+// Parameters:
+//   - l: The list to walk, of any kind.
+//   - n: The size of each window. Windows panics if n is less than 1.
+//
+// Returns:
+//   - iter.Seq[[]T]: An iterator over the windows.
+//
+// Example:
+//
+//	for window := range list.Windows(samples, 3) {
+//	    fmt.Println(average(window))
+//	}
+func Windows[L AnyList[T], T comparable](l L, n int) iter.Seq[[]T] {
+	return WindowsSeq(l.values(), n)
+}
+
+// Returns an iterator over every window of n consecutive elements of a
+// sequence, sliding one element at a time. A sequence shorter than n yields no
+// windows.
+//
+// To avoid copying, every window is a view into a single buffer of 2n elements
+// that is overwritten as the window slides: a yielded slice is only valid until
+// the next iteration and must be cloned to be retained.
+//
+// Parameters:
+//   - seq: The sequence to walk.
+//   - n: The size of each window. WindowsSeq panics if n is less than 1.
+//
+// Returns:
+//   - iter.Seq[[]T]: An iterator over the windows.
+//
+// Example:
+//
+//	for window := range list.WindowsSeq(samples.All(), 3) {
+//	    fmt.Println(average(window))
+//	}
+func WindowsSeq[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("list: window size cannot be less than 1")
+	}
+	return func(yield func([]T) bool) {
+		// Each value is stored twice, n positions apart, so that the latest n
+		// values are always contiguous somewhere in the buffer.
+		buffer := make([]T, 2*n)
+		count := 0
+		for value := range seq {
+			slot := count % n
+			buffer[slot] = value
+			buffer[slot+n] = value
+			count++
+			if count < n {
+				continue
+			}
+			start := count % n
+			if !yield(buffer[start : start+n : start+n]) {
+				return
+			}
+		}
+	}
+}
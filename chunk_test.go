@@ -0,0 +1,78 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3, 4, 5)
+	chunks := Chunk(list, 2)
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(chunks))
+	}
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+	for i, chunk := range chunks {
+		if !slices.Equal(chunk.ToSlice(), expected[i]) {
+			t.Errorf("chunk %d: expected %v, got %v", i, expected[i], chunk.ToSlice())
+		}
+	}
+	if chunks := Chunk(NewDoublyLinkedList[int](), 3); len(chunks) != 0 {
+		t.Errorf("expected no chunks, got %d", len(chunks))
+	}
+}
+
+func TestChunkPanicsOnInvalidSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Chunk to panic for size 0")
+		}
+	}()
+	Chunk(SinglyLinkedListOf(1), 0)
+}
+
+func TestChunkSeqYieldsIndependentSlices(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3, 4)
+	chunks := slices.Collect(ChunkSeq(list.All(), 2))
+	if len(chunks) != 2 || !slices.Equal(chunks[0], []int{1, 2}) || !slices.Equal(chunks[1], []int{3, 4}) {
+		t.Errorf("expected [[1 2] [3 4]], got %v", chunks)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	list := SinglyLinkedListOf(1, 2, 3, 4, 5)
+	var windows [][]int
+	for window := range Windows(list, 3) {
+		windows = append(windows, slices.Clone(window))
+	}
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if len(windows) != len(expected) {
+		t.Fatalf("expected %d windows, got %d", len(expected), len(windows))
+	}
+	for i := range expected {
+		if !slices.Equal(windows[i], expected[i]) {
+			t.Errorf("window %d: expected %v, got %v", i, expected[i], windows[i])
+		}
+	}
+	count := 0
+	for range Windows(SinglyLinkedListOf(1, 2), 3) {
+		count++
+	}
+	if count != 0 {
+		t.Errorf("expected no windows for a short list, got %d", count)
+	}
+}
+
+func TestWindowsSeqDoesNotAllocatePerWindow(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3, 4, 5, 6, 7, 8)
+	seq := WindowsSeq(list.All(), 2)
+	sum := 0
+	allocs := testing.AllocsPerRun(10, func() {
+		for window := range seq {
+			sum += window[0]
+		}
+	})
+	if allocs > 3 {
+		t.Errorf("expected a constant number of allocations, got %v", allocs)
+	}
+}
@@ -89,7 +89,12 @@
 // Random access operations (Get, Set) have O(n) complexity due to linear traversal.
 package list
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+)
 
 // Represents a generic circular doubly linked list.
 //
@@ -97,6 +102,11 @@ import "fmt"
 type CircularDoublyLinkedList[T comparable] struct {
 	tail *DoublyLinkedNode[T]
 	size int
+	// Incremented on every structural change so that views can detect that
+	// they have been invalidated.
+	version int
+	// Notified of mutations when set; see SetObserver.
+	observer Observer[T]
 }
 
 // Creates and returns a new empty circular doubly linked list.
@@ -111,6 +121,42 @@ func NewCircularDoublyLinkedList[T comparable]() *CircularDoublyLinkedList[T] {
 	return &CircularDoublyLinkedList[T]{}
 }
 
+// Creates and returns a new circular doubly linked list holding the given
+// values, in order.
+//
+// Parameters:
+//   - values: The values to store.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.CircularDoublyLinkedListOf(1, 2, 3)
+func CircularDoublyLinkedListOf[T comparable](values ...T) *CircularDoublyLinkedList[T] {
+	return CircularDoublyLinkedListFromSlice(values)
+}
+
+// Creates and returns a new circular doubly linked list holding the elements
+// of a slice.
+//
+// The slice is not retained.
+//
+// Parameters:
+//   - values: The slice to copy.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.CircularDoublyLinkedListFromSlice([]int{1, 2, 3})
+func CircularDoublyLinkedListFromSlice[T comparable](values []T) *CircularDoublyLinkedList[T] {
+	l := NewCircularDoublyLinkedList[T]()
+	l.AppendAll(values...)
+	return l
+}
+
 // Returns the first node of the list.
 //
 // Returns:
@@ -169,8 +215,18 @@ func (l *CircularDoublyLinkedList[T]) IsEmpty() bool {
 //	list.Clear()
 //	fmt.Println(list.IsEmpty()) // true
 func (l *CircularDoublyLinkedList[T]) Clear() {
+	head, size := l.Head(), l.size
+	l.reset()
+	if l.observer != nil {
+		notifyDoublyRemoved(l.observer, 0, head, size)
+	}
+}
+
+// Empties the list without notifying the observer.
+func (l *CircularDoublyLinkedList[T]) reset() {
 	l.tail = nil
 	l.size = 0
+	l.version++
 }
 
 // Inserts a new element at the beginning of the list.
@@ -182,6 +238,14 @@ func (l *CircularDoublyLinkedList[T]) Clear() {
 //
 //	list.Prepend(5)
 func (l *CircularDoublyLinkedList[T]) Prepend(value T) {
+	l.prepend(value)
+	if l.observer != nil {
+		l.observer.OnInsert(0, value)
+	}
+}
+
+// Links a new node at the head without notifying the observer.
+func (l *CircularDoublyLinkedList[T]) prepend(value T) {
 	newNode := NewDoublyLinkedNode(value)
 	if l.IsEmpty() {
 		newNode.next = newNode
@@ -195,6 +259,7 @@ func (l *CircularDoublyLinkedList[T]) Prepend(value T) {
 		l.Tail().next = newNode
 	}
 	l.size++
+	l.version++
 }
 
 // Inserts a new element at the end of the list.
@@ -206,8 +271,129 @@ func (l *CircularDoublyLinkedList[T]) Prepend(value T) {
 //
 //	list.Append(10)
 func (l *CircularDoublyLinkedList[T]) Append(value T) {
-	l.Prepend(value)
+	l.prepend(value)
 	l.tail = l.Tail().Next()
+	if l.observer != nil {
+		l.observer.OnInsert(l.size-1, value)
+	}
+}
+
+// Inserts the given values at the beginning of the list, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.PrependAll(1, 2, 3)
+func (l *CircularDoublyLinkedList[T]) PrependAll(values ...T) {
+	first, last := newDoublyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(l.Tail(), first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, 0, values)
+	}
+}
+
+// Inserts the given values at the end of the list, preserving their order,
+// linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.AppendAll(4, 5, 6)
+func (l *CircularDoublyLinkedList[T]) AppendAll(values ...T) {
+	first, last := newDoublyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(l.Tail(), first, last, len(values))
+	l.tail = last
+	if l.observer != nil {
+		notifyInserted(l.observer, l.size-len(values), values)
+	}
+}
+
+// Inserts the given values starting at the specified index, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - index: Position at which to insert the first value (0-based).
+//   - values: The values to insert.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := list.InsertAllAt(1, 7, 8, 9)
+func (l *CircularDoublyLinkedList[T]) InsertAllAt(index int, values ...T) error {
+	if index < 0 || index > l.Size() {
+		return newIndexError("InsertAllAt", index, l.Size())
+	}
+	if index == l.Size() {
+		l.AppendAll(values...)
+		return nil
+	}
+	first, last := newDoublyChain(slices.Values(values))
+	if first == nil {
+		return nil
+	}
+	prev := l.Tail()
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	l.linkAfter(prev, first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, index, values)
+	}
+	return nil
+}
+
+// Splices the chain from first to last after prev, keeping the ring closed.
+//
+// When the list is empty, prev is ignored and the chain becomes the whole ring.
+func (l *CircularDoublyLinkedList[T]) linkAfter(prev, first, last *DoublyLinkedNode[T], count int) {
+	if l.IsEmpty() {
+		last.next = first
+		first.prev = last
+		l.tail = last
+		l.size = count
+		l.version++
+		return
+	}
+	next := prev.Next()
+	prev.next = first
+	first.prev = prev
+	last.next = next
+	next.prev = last
+	l.size += count
+	l.version++
+}
+
+// Returns the value at the head. The list must not be empty.
+func (l *CircularDoublyLinkedList[T]) first() T {
+	return l.Head().Value()
+}
+
+// Unlinks the head node and links it at the end of dst. The list must not be
+// empty.
+func (l *CircularDoublyLinkedList[T]) moveFirstTo(dst *CircularDoublyLinkedList[T]) {
+	node := l.Head()
+	l.unlink(node)
+	dst.linkAfter(dst.Tail(), node, node, 1)
+	dst.tail = node
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	if dst.observer != nil {
+		dst.observer.OnInsert(dst.size-1, node.Value())
+	}
 }
 
 // Searches for the first node containing the specified value.
@@ -235,49 +421,199 @@ func (l *CircularDoublyLinkedList[T]) Find(value T) *DoublyLinkedNode[T] {
 	return nil
 }
 
+// Returns the index of the first occurrence of the specified value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.IndexOf(7)
+func (l *CircularDoublyLinkedList[T]) IndexOf(value T) int {
+	current := l.Head()
+	for index := range l.Size() {
+		if current.Value() == value {
+			return index
+		}
+		current = current.Next()
+	}
+	return -1
+}
+
+// Returns the index of the last occurrence of the specified value, walking backward from the tail.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.LastIndexOf(7)
+func (l *CircularDoublyLinkedList[T]) LastIndexOf(value T) int {
+	current := l.Tail()
+	for index := l.Size() - 1; index >= 0; index-- {
+		if current.Value() == value {
+			return index
+		}
+		current = current.Prev()
+	}
+	return -1
+}
+
+// Searches for the first node whose value satisfies the predicate.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *DoublyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindFunc(func(v int) bool { return v > 10 })
+func (l *CircularDoublyLinkedList[T]) FindFunc(predicate func(T) bool) *DoublyLinkedNode[T] {
+	current := l.Head()
+	for range l.Size() {
+		if predicate(current.Value()) {
+			return current
+		}
+		current = current.Next()
+	}
+	return nil
+}
+
+// Searches for the last node whose value satisfies the predicate, walking backward from the tail.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *DoublyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindLast(func(v int) bool { return v > 10 })
+func (l *CircularDoublyLinkedList[T]) FindLast(predicate func(T) bool) *DoublyLinkedNode[T] {
+	current := l.Tail()
+	for range l.Size() {
+		if predicate(current.Value()) {
+			return current
+		}
+		current = current.Prev()
+	}
+	return nil
+}
+
+// Collects every node whose value satisfies the predicate, in list order.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - []*DoublyLinkedNode[T]: The matching nodes, or nil if there are none.
+//
+// Example:
+//
+//	nodes := list.FindAll(func(v int) bool { return v%2 == 0 })
+func (l *CircularDoublyLinkedList[T]) FindAll(predicate func(T) bool) []*DoublyLinkedNode[T] {
+	var nodes []*DoublyLinkedNode[T]
+	current := l.Head()
+	for range l.Size() {
+		if predicate(current.Value()) {
+			nodes = append(nodes, current)
+		}
+		current = current.Next()
+	}
+	return nodes
+}
+
+// Counts the occurrences of the specified value.
+//
+// Parameters:
+//   - value: The value to count.
+//
+// Returns:
+//   - int: Number of elements equal to value.
+//
+// Example:
+//
+//	n := list.Count(7)
+func (l *CircularDoublyLinkedList[T]) Count(value T) int {
+	count := 0
+	current := l.Head()
+	for range l.Size() {
+		if current.Value() == value {
+			count++
+		}
+		current = current.Next()
+	}
+	return count
+}
+
 // Removes the first element from the list.
 //
 // If the list is empty, the operation has no effect.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveFirst()
-func (l *CircularDoublyLinkedList[T]) RemoveFirst() {
+func (l *CircularDoublyLinkedList[T]) RemoveFirst() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
+	head := l.Head()
 	if l.Size() == 1 {
-		l.Clear()
-		return
+		l.reset()
+	} else {
+		newHead := head.Next()
+		l.Tail().next = newHead
+		newHead.prev = l.Tail()
+		l.size--
+		l.version++
 	}
-	head := l.Head()
-	newHead := head.Next()
-	l.Tail().next = newHead
-	newHead.prev = l.Tail()
-	l.size--
+	if l.observer != nil {
+		l.observer.OnRemove(0, head.Value())
+	}
+	return true
 }
 
 // Removes the last element from the list.
 //
 // If the list is empty, the operation has no effect.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveLast()
-func (l *CircularDoublyLinkedList[T]) RemoveLast() {
+func (l *CircularDoublyLinkedList[T]) RemoveLast() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
+	last := l.Tail()
 	if l.Size() == 1 {
-		l.Clear()
-		return
+		l.reset()
+	} else {
+		prev := last.Prev()
+		prev.next = l.Head()
+		l.Head().prev = prev
+		l.tail = prev
+		l.size--
+		l.version++
 	}
-	last := l.Tail()
-	prev := last.Prev()
-	prev.next = l.Head()
-	l.Head().prev = prev
-	l.tail = prev
-	l.size--
+	if l.observer != nil {
+		l.observer.OnRemove(l.size, last.Value())
+	}
+	return true
 }
 
 // Deletes the first occurrence of the specified value from the list.
@@ -287,19 +623,25 @@ func (l *CircularDoublyLinkedList[T]) RemoveLast() {
 // Parameters:
 //   - value: The value to remove.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the value was not found.
+//
 // Example:
 //
 //	list.Remove(10)
-func (l *CircularDoublyLinkedList[T]) Remove(value T) {
+func (l *CircularDoublyLinkedList[T]) Remove(value T) bool {
+	if l.observer != nil {
+		return l.removeObserved(value)
+	}
 	if l.IsEmpty() {
-		return
+		return false
 	}
 	current := l.Head()
 	for range l.Size() {
 		if current.Value() == value {
 			if l.Size() == 1 {
-				l.Clear()
-				return
+				l.reset()
+				return true
 			}
 			prev := current.Prev()
 			next := current.Next()
@@ -309,10 +651,308 @@ func (l *CircularDoublyLinkedList[T]) Remove(value T) {
 				l.tail = prev
 			}
 			l.size--
-			return
+			l.version++
+			return true
 		}
 		current = current.Next()
 	}
+	return false
+}
+
+// Removes and returns the element at the specified index.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//
+// Returns:
+//   - T: The removed value, or the zero value on error.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := list.RemoveAt(1)
+func (l *CircularDoublyLinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.Size() {
+		var zero T
+		return zero, newIndexError("RemoveAt", index, l.Size())
+	}
+	node := l.nodeAt(index)
+	l.unlink(node)
+	if l.observer != nil {
+		l.observer.OnRemove(index, node.Value())
+	}
+	return node.Value(), nil
+}
+
+// Deletes every occurrence of the specified value from the list.
+//
+// Parameters:
+//   - value: The value to remove.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveAll(10)
+func (l *CircularDoublyLinkedList[T]) RemoveAll(value T) int {
+	return l.RemoveIf(func(v T) bool { return v == value })
+}
+
+// Deletes every element for which the predicate returns true, in a single
+// pass around the ring.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be removed.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveIf(func(v int) bool { return v%2 == 0 })
+func (l *CircularDoublyLinkedList[T]) RemoveIf(predicate func(T) bool) int {
+	removed, index := 0, 0
+	current := l.Head()
+	for range l.Size() {
+		next := current.Next()
+		if predicate(current.Value()) {
+			l.unlink(current)
+			removed++
+			if l.observer != nil {
+				l.observer.OnRemove(index, current.Value())
+			}
+		} else {
+			index++
+		}
+		current = next
+	}
+	return removed
+}
+
+// Keeps only the elements for which the predicate returns true.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be kept.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Retain(func(v int) bool { return v > 0 })
+func (l *CircularDoublyLinkedList[T]) Retain(predicate func(T) bool) int {
+	return l.RemoveIf(func(v T) bool { return !predicate(v) })
+}
+
+// Removes every element equal to an earlier one, keeping the first
+// occurrence, in a single pass that tracks seen values in a set.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Unique()
+func (l *CircularDoublyLinkedList[T]) Unique() int {
+	seen := make(map[T]struct{}, l.Size())
+	return l.RemoveIf(func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+}
+
+// Removes every element that the equality function reports as equal to an
+// earlier one, keeping the first occurrence.
+//
+// Since no set can be built from an arbitrary equality, this runs in O(n*k)
+// where k is the number of distinct elements.
+//
+// Parameters:
+//   - equal: Function reporting whether two values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.UniqueFunc(strings.EqualFold)
+func (l *CircularDoublyLinkedList[T]) UniqueFunc(equal func(a, b T) bool) int {
+	var kept []T
+	return l.RemoveIf(func(v T) bool {
+		for _, k := range kept {
+			if equal(k, v) {
+				return true
+			}
+		}
+		kept = append(kept, v)
+		return false
+	})
+}
+
+// Collapses runs of adjacent equal elements into a single element, like
+// slices.Compact.
+//
+// The comparison does not wrap around: the head is never compared with the
+// tail.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Compact()
+func (l *CircularDoublyLinkedList[T]) Compact() int {
+	return l.CompactFunc(func(a, b T) bool { return a == b })
+}
+
+// Collapses runs of adjacent elements that the equality function reports as
+// equal, keeping the first element of each run, like slices.CompactFunc.
+//
+// The comparison does not wrap around: the head is never compared with the
+// tail.
+//
+// Parameters:
+//   - equal: Function reporting whether two adjacent values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.CompactFunc(strings.EqualFold)
+func (l *CircularDoublyLinkedList[T]) CompactFunc(equal func(a, b T) bool) int {
+	var last T
+	started := false
+	return l.RemoveIf(func(v T) bool {
+		if started && equal(last, v) {
+			return true
+		}
+		last, started = v, true
+		return false
+	})
+}
+
+// Splits the list into the elements that satisfy the predicate and those that
+// do not, preserving their relative order.
+//
+// The nodes are relinked into the two results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element belongs to the first list.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: The elements for which predicate returned true.
+//   - *CircularDoublyLinkedList[T]: The remaining elements.
+//
+// Example:
+//
+//	evens, odds := list.Partition(func(v int) bool { return v%2 == 0 })
+func (l *CircularDoublyLinkedList[T]) Partition(predicate func(T) bool) (*CircularDoublyLinkedList[T], *CircularDoublyLinkedList[T]) {
+	matched, rest := NewCircularDoublyLinkedList[T](), NewCircularDoublyLinkedList[T]()
+	for !l.IsEmpty() {
+		if predicate(l.first()) {
+			l.moveFirstTo(matched)
+		} else {
+			l.moveFirstTo(rest)
+		}
+	}
+	return matched, rest
+}
+
+// Splits the list into the elements less than, equal to and greater than the
+// pivot, preserving their relative order within each part.
+//
+// The nodes are relinked into the three results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - pivot: The value to partition around.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: The elements less than pivot.
+//   - *CircularDoublyLinkedList[T]: The elements equal to pivot.
+//   - *CircularDoublyLinkedList[T]: The elements greater than pivot.
+//
+// Example:
+//
+//	less, equal, greater := list.PartitionAround(5, cmp.Compare[int])
+func (l *CircularDoublyLinkedList[T]) PartitionAround(pivot T, cmp func(x, y T) int) (*CircularDoublyLinkedList[T], *CircularDoublyLinkedList[T], *CircularDoublyLinkedList[T]) {
+	less, equal, greater := NewCircularDoublyLinkedList[T](), NewCircularDoublyLinkedList[T](), NewCircularDoublyLinkedList[T]()
+	for !l.IsEmpty() {
+		switch c := cmp(l.first(), pivot); {
+		case c < 0:
+			l.moveFirstTo(less)
+		case c > 0:
+			l.moveFirstTo(greater)
+		default:
+			l.moveFirstTo(equal)
+		}
+	}
+	return less, equal, greater
+}
+
+// Deletes the elements in the half-open range [from, to).
+//
+// Parameters:
+//   - from: Index of the first element to remove.
+//   - to: Index one past the last element to remove.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.RemoveRange(1, 3)
+func (l *CircularDoublyLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange("RemoveRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	if to-from == l.Size() {
+		l.Clear()
+		return nil
+	}
+	first := l.nodeAt(from)
+	last := first
+	for range to - from - 1 {
+		last = last.Next()
+	}
+	before, after := first.Prev(), last.Next()
+	before.next = after
+	after.prev = before
+	if last == l.Tail() {
+		l.tail = before
+	}
+	l.size -= to - from
+	l.version++
+	if l.observer != nil {
+		notifyDoublyRemoved(l.observer, from, first, to-from)
+	}
+	return nil
+}
+
+// Detaches the given node, which must belong to the list, keeping the ring
+// closed.
+func (l *CircularDoublyLinkedList[T]) unlink(node *DoublyLinkedNode[T]) {
+	if l.Size() == 1 {
+		l.reset()
+		return
+	}
+	node.prev.next = node.Next()
+	node.next.prev = node.Prev()
+	if node == l.Tail() {
+		l.tail = node.Prev()
+	}
+	l.size--
+	l.version++
 }
 
 // Returns a string representation of the list.
@@ -324,19 +964,72 @@ func (l *CircularDoublyLinkedList[T]) Remove(value T) {
 //
 //	fmt.Println(list.String()) // CircularSinglyLinkedList: [1] -> [2] -> [3]
 func (l *CircularDoublyLinkedList[T]) String() string {
-	if l.IsEmpty() {
-		return "CircularDoublyLinkedList: []"
+	return listString(l.layout())
+}
+
+// Implements fmt.Formatter.
+//
+// The verbs %v and %s print the compact form returned by String, %+v also
+// reports the size and the head and tail values, and %#v prints Go syntax that
+// rebuilds the list, such as list.CircularDoublyLinkedListOf[int](1, 2). Any
+// other verb is applied to each element, along with the # flag. A precision
+// (or, failing that, a width) limits how many elements are printed, replacing
+// the rest with an ellipsis.
+//
+// Parameters:
+//   - f: The formatter state.
+//   - verb: The formatting verb.
+//
+// Example:
+//
+//	fmt.Printf("%.2v\n", list) // CircularDoublyLinkedList: [0] <-> [1] <-> ...
+func (l *CircularDoublyLinkedList[T]) Format(f fmt.State, verb rune) {
+	formatList(f, verb, l.layout())
+}
+
+// Streams the compact representation of the list to w without building the
+// whole string in memory.
+//
+// Parameters:
+//   - w: The destination writer.
+//
+// Returns:
+//   - int64: Number of bytes written.
+//   - error: The first error returned by w, if any.
+//
+// Example:
+//
+//	_, err := list.WriteTo(os.Stdout)
+func (l *CircularDoublyLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeList(w, l.layout(), defaultFormatOptions)
+}
+
+// Describes the list for the shared formatting helpers.
+func (l *CircularDoublyLinkedList[T]) layout() listLayout[T] {
+	layout := listLayout[T]{
+		name:      "CircularDoublyLinkedList",
+		separator: " <-> ",
+		size:      l.Size(),
+		values:    l.values(),
 	}
-	result := "CircularDoublyLinkedList: "
-	current := l.Head()
-	for i := range l.Size() {
-		result += fmt.Sprintf("[%v]", current.Value())
-		if i < l.Size()-1 {
-			result += " <-> "
+	if !l.IsEmpty() {
+		layout.head = l.Head().Value()
+		layout.tail = l.Tail().Value()
+	}
+	return layout
+}
+
+// Returns an iterator over the values of the list, from head to tail.
+func (l *CircularDoublyLinkedList[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := l.Head()
+		for range l.Size() {
+			if !yield(current.Value()) {
+				return
+			}
+			current = current.Next()
 		}
-		current = current.Next()
 	}
-	return result
 }
 
 // Inserts a new element at the specified index.
@@ -346,14 +1039,14 @@ func (l *CircularDoublyLinkedList[T]) String() string {
 //   - value: The value to insert.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	err := list.InsertAt(2, 99)
 func (l *CircularDoublyLinkedList[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > l.Size() {
-		return fmt.Errorf("index %d out of bounds", index)
+		return newIndexError("InsertAt", index, l.Size())
 	}
 	if index == 0 {
 		l.Prepend(value)
@@ -374,6 +1067,10 @@ func (l *CircularDoublyLinkedList[T]) InsertAt(index int, value T) error {
 	newNode.next = current
 	current.prev = newNode
 	l.size++
+	l.version++
+	if l.observer != nil {
+		l.observer.OnInsert(index, value)
+	}
 	return nil
 }
 
@@ -384,20 +1081,35 @@ func (l *CircularDoublyLinkedList[T]) InsertAt(index int, value T) error {
 //
 // Returns:
 //   - *SinglyLinkedNode[T]: Pointer to the node.
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	node, err := list.Get(1)
 func (l *CircularDoublyLinkedList[T]) Get(index int) (*DoublyLinkedNode[T], error) {
 	if index < 0 || index >= l.Size() {
-		return nil, fmt.Errorf("index %d out of bounds", index)
+		return nil, newIndexError("Get", index, l.Size())
 	}
-	current := l.Head()
-	for range index {
-		current = current.Next()
+	return l.nodeAt(index), nil
+}
+
+// Returns the node at the given index, walking from whichever end of the list
+// is closer.
+//
+// The index must be within bounds.
+func (l *CircularDoublyLinkedList[T]) nodeAt(index int) *DoublyLinkedNode[T] {
+	if index < l.Size()/2 {
+		current := l.Head()
+		for range index {
+			current = current.Next()
+		}
+		return current
+	}
+	current := l.Tail()
+	for range l.Size() - 1 - index {
+		current = current.Prev()
 	}
-	return current, nil
+	return current
 }
 
 // Updates the value of the node at the specified index.
@@ -407,17 +1119,21 @@ func (l *CircularDoublyLinkedList[T]) Get(index int) (*DoublyLinkedNode[T], erro
 //   - value: New value to set.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	err := list.Set(0, 42)
 func (l *CircularDoublyLinkedList[T]) Set(index int, value T) error {
-	node, err := l.Get(index)
-	if err != nil {
-		return err
+	if index < 0 || index >= l.Size() {
+		return newIndexError("Set", index, l.Size())
+	}
+	node := l.nodeAt(index)
+	old := node.Value()
+	node.SetValue(value)
+	if l.observer != nil {
+		l.observer.OnSet(index, old, value)
 	}
-	node.value = value
 	return nil
 }
 
@@ -437,6 +1153,96 @@ func (l *CircularDoublyLinkedList[T]) Reverse() {
 		current = current.Prev()
 	}
 	l.tail = originalHead
+	l.version++
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the order of the elements in the half-open range [from, to) by
+// relinking their nodes in place.
+//
+// Parameters:
+//   - from: Index of the first element to reverse.
+//   - to: Index one past the last element to reverse.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.ReverseRange(1, 4)
+func (l *CircularDoublyLinkedList[T]) ReverseRange(from, to int) error {
+	if err := checkRange("ReverseRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if to-from < 2 {
+		return nil
+	}
+	if to-from == l.Size() {
+		l.Reverse()
+		return nil
+	}
+	l.reverseSegment(l.nodeAt(from), to-from)
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+	return nil
+}
+
+// Reverses every consecutive group of k elements in place, starting at the
+// head. A trailing group with fewer than k elements is reversed as well.
+//
+// Parameters:
+//   - k: The size of each group. ReverseGroups panics if k is less than 1.
+//
+// Example:
+//
+//	list.ReverseGroups(2) // [1 2 3 4 5] becomes [2 1 4 3 5]
+func (l *CircularDoublyLinkedList[T]) ReverseGroups(k int) {
+	if k < 1 {
+		panic("list: group size cannot be less than 1")
+	}
+	if k >= l.Size() {
+		l.Reverse()
+		return
+	}
+	first := l.Head()
+	for remaining := l.Size(); remaining > 0; remaining -= k {
+		first = l.reverseSegment(first, min(k, remaining)).Next()
+	}
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the count nodes starting at first and returns first, which now
+// ends the reversed segment, keeping the ring closed.
+//
+// count must be at least 1 and less than the size of the list.
+func (l *CircularDoublyLinkedList[T]) reverseSegment(first *DoublyLinkedNode[T], count int) *DoublyLinkedNode[T] {
+	l.version++
+	before := first.Prev()
+	var reversed *DoublyLinkedNode[T]
+	current := first
+	containsTail := false
+	for range count {
+		if current == l.Tail() {
+			containsTail = true
+		}
+		next := current.Next()
+		current.next, current.prev = current.Prev(), next
+		reversed = current
+		current = next
+	}
+	first.next = current
+	current.prev = first
+	reversed.prev = before
+	before.next = reversed
+	if containsTail {
+		l.tail = first
+	}
+	return first
 }
 
 // Reports whether the list contains the specified value.
@@ -472,3 +1278,33 @@ func (l *CircularDoublyLinkedList[T]) ForEach(action func(T)) {
 		current = current.Next()
 	}
 }
+
+// Returns an iterator over the elements of the list, from head to tail.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range list.All() {
+//	    fmt.Println(v)
+//	}
+func (l *CircularDoublyLinkedList[T]) All() iter.Seq[T] {
+	return l.values()
+}
+
+// Returns a slice containing all elements of the list.
+//
+// Returns:
+//   - []T: Slice of all elements, from head to tail.
+//
+// Example:
+//
+//	slice := list.ToSlice()
+func (l *CircularDoublyLinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, l.Size())
+	for value := range l.values() {
+		result = append(result, value)
+	}
+	return result
+}
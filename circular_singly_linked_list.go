@@ -89,7 +89,12 @@
 // Random access operations (Get, Set) have O(n) complexity due to linear traversal.
 package list
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+)
 
 // Represents a generic circular singly linked list.
 //
@@ -97,6 +102,8 @@ import "fmt"
 type CircularSinglyLinkedList[T comparable] struct {
 	tail *SinglyLinkedNode[T]
 	size int
+	// Notified of mutations when set; see SetObserver.
+	observer Observer[T]
 }
 
 // Creates and returns a new empty circular singly linked list.
@@ -111,6 +118,42 @@ func NewCircularSinglyLinkedList[T comparable]() *CircularSinglyLinkedList[T] {
 	return &CircularSinglyLinkedList[T]{}
 }
 
+// Creates and returns a new circular singly linked list holding the given
+// values, in order.
+//
+// Parameters:
+//   - values: The values to store.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.CircularSinglyLinkedListOf(1, 2, 3)
+func CircularSinglyLinkedListOf[T comparable](values ...T) *CircularSinglyLinkedList[T] {
+	return CircularSinglyLinkedListFromSlice(values)
+}
+
+// Creates and returns a new circular singly linked list holding the elements
+// of a slice.
+//
+// The slice is not retained.
+//
+// Parameters:
+//   - values: The slice to copy.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.CircularSinglyLinkedListFromSlice([]int{1, 2, 3})
+func CircularSinglyLinkedListFromSlice[T comparable](values []T) *CircularSinglyLinkedList[T] {
+	l := NewCircularSinglyLinkedList[T]()
+	l.AppendAll(values...)
+	return l
+}
+
 // Returns the first node of the list.
 //
 // Returns:
@@ -169,6 +212,15 @@ func (l *CircularSinglyLinkedList[T]) IsEmpty() bool {
 //	list.Clear()
 //	fmt.Println(list.IsEmpty()) // true
 func (l *CircularSinglyLinkedList[T]) Clear() {
+	head, size := l.Head(), l.size
+	l.reset()
+	if l.observer != nil {
+		notifySinglyRemoved(l.observer, 0, head, size)
+	}
+}
+
+// Empties the list without notifying the observer.
+func (l *CircularSinglyLinkedList[T]) reset() {
 	l.tail = nil
 	l.size = 0
 }
@@ -182,6 +234,14 @@ func (l *CircularSinglyLinkedList[T]) Clear() {
 //
 //	list.Prepend(5)
 func (l *CircularSinglyLinkedList[T]) Prepend(value T) {
+	l.prepend(value)
+	if l.observer != nil {
+		l.observer.OnInsert(0, value)
+	}
+}
+
+// Links a new node at the head without notifying the observer.
+func (l *CircularSinglyLinkedList[T]) prepend(value T) {
 	newNode := NewSinglyLinkedNode(value)
 	if l.IsEmpty() {
 		newNode.next = newNode
@@ -202,8 +262,122 @@ func (l *CircularSinglyLinkedList[T]) Prepend(value T) {
 //
 //	list.Append(10)
 func (l *CircularSinglyLinkedList[T]) Append(value T) {
-	l.Prepend(value)
+	l.prepend(value)
 	l.tail = l.Tail().Next()
+	if l.observer != nil {
+		l.observer.OnInsert(l.size-1, value)
+	}
+}
+
+// Inserts the given values at the beginning of the list, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.PrependAll(1, 2, 3)
+func (l *CircularSinglyLinkedList[T]) PrependAll(values ...T) {
+	first, last := newSinglyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(l.Tail(), first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, 0, values)
+	}
+}
+
+// Inserts the given values at the end of the list, preserving their order,
+// linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.AppendAll(4, 5, 6)
+func (l *CircularSinglyLinkedList[T]) AppendAll(values ...T) {
+	first, last := newSinglyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(l.Tail(), first, last, len(values))
+	l.tail = last
+	if l.observer != nil {
+		notifyInserted(l.observer, l.size-len(values), values)
+	}
+}
+
+// Inserts the given values starting at the specified index, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - index: Position at which to insert the first value (0-based).
+//   - values: The values to insert.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := list.InsertAllAt(1, 7, 8, 9)
+func (l *CircularSinglyLinkedList[T]) InsertAllAt(index int, values ...T) error {
+	if index < 0 || index > l.Size() {
+		return newIndexError("InsertAllAt", index, l.Size())
+	}
+	if index == l.Size() {
+		l.AppendAll(values...)
+		return nil
+	}
+	first, last := newSinglyChain(slices.Values(values))
+	if first == nil {
+		return nil
+	}
+	prev := l.Tail()
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	l.linkAfter(prev, first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, index, values)
+	}
+	return nil
+}
+
+// Splices the chain from first to last after prev, keeping the ring closed.
+//
+// When the list is empty, prev is ignored and the chain becomes the whole ring.
+func (l *CircularSinglyLinkedList[T]) linkAfter(prev, first, last *SinglyLinkedNode[T], count int) {
+	if l.IsEmpty() {
+		last.next = first
+		l.tail = last
+		l.size = count
+		return
+	}
+	last.next = prev.Next()
+	prev.next = first
+	l.size += count
+}
+
+// Returns the value at the head. The list must not be empty.
+func (l *CircularSinglyLinkedList[T]) first() T {
+	return l.Head().Value()
+}
+
+// Unlinks the head node and links it at the end of dst. The list must not be
+// empty.
+func (l *CircularSinglyLinkedList[T]) moveFirstTo(dst *CircularSinglyLinkedList[T]) {
+	node := l.unlinkAfter(l.Tail())
+	dst.linkAfter(dst.Tail(), node, node, 1)
+	dst.tail = node
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	if dst.observer != nil {
+		dst.observer.OnInsert(dst.size-1, node.Value())
+	}
 }
 
 // Searches for the first node containing the specified value.
@@ -234,47 +408,199 @@ func (l *CircularSinglyLinkedList[T]) Find(value T) *SinglyLinkedNode[T] {
 	return nil
 }
 
+// Returns the index of the first occurrence of the specified value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.IndexOf(7)
+func (l *CircularSinglyLinkedList[T]) IndexOf(value T) int {
+	current := l.Head()
+	for index := range l.Size() {
+		if current.Value() == value {
+			return index
+		}
+		current = current.Next()
+	}
+	return -1
+}
+
+// Returns the index of the last occurrence of the specified value, scanning the whole list once.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.LastIndexOf(7)
+func (l *CircularSinglyLinkedList[T]) LastIndexOf(value T) int {
+	last := -1
+	current := l.Head()
+	for index := range l.Size() {
+		if current.Value() == value {
+			last = index
+		}
+		current = current.Next()
+	}
+	return last
+}
+
+// Searches for the first node whose value satisfies the predicate.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *SinglyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindFunc(func(v int) bool { return v > 10 })
+func (l *CircularSinglyLinkedList[T]) FindFunc(predicate func(T) bool) *SinglyLinkedNode[T] {
+	current := l.Head()
+	for range l.Size() {
+		if predicate(current.Value()) {
+			return current
+		}
+		current = current.Next()
+	}
+	return nil
+}
+
+// Searches for the last node whose value satisfies the predicate, scanning the whole list once.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *SinglyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindLast(func(v int) bool { return v > 10 })
+func (l *CircularSinglyLinkedList[T]) FindLast(predicate func(T) bool) *SinglyLinkedNode[T] {
+	var last *SinglyLinkedNode[T]
+	current := l.Head()
+	for range l.Size() {
+		if predicate(current.Value()) {
+			last = current
+		}
+		current = current.Next()
+	}
+	return last
+}
+
+// Collects every node whose value satisfies the predicate, in list order.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - []*SinglyLinkedNode[T]: The matching nodes, or nil if there are none.
+//
+// Example:
+//
+//	nodes := list.FindAll(func(v int) bool { return v%2 == 0 })
+func (l *CircularSinglyLinkedList[T]) FindAll(predicate func(T) bool) []*SinglyLinkedNode[T] {
+	var nodes []*SinglyLinkedNode[T]
+	current := l.Head()
+	for range l.Size() {
+		if predicate(current.Value()) {
+			nodes = append(nodes, current)
+		}
+		current = current.Next()
+	}
+	return nodes
+}
+
+// Counts the occurrences of the specified value.
+//
+// Parameters:
+//   - value: The value to count.
+//
+// Returns:
+//   - int: Number of elements equal to value.
+//
+// Example:
+//
+//	n := list.Count(7)
+func (l *CircularSinglyLinkedList[T]) Count(value T) int {
+	count := 0
+	current := l.Head()
+	for range l.Size() {
+		if current.Value() == value {
+			count++
+		}
+		current = current.Next()
+	}
+	return count
+}
+
 // Removes the first element from the list.
 //
 // If the list is empty, the operation has no effect.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveFirst()
-func (l *CircularSinglyLinkedList[T]) RemoveFirst() {
+func (l *CircularSinglyLinkedList[T]) RemoveFirst() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
+	node := l.Head()
 	if l.Size() == 1 {
-		l.Clear()
-		return
+		l.reset()
+	} else {
+		l.Tail().next = node.Next()
+		l.size--
 	}
-	l.Tail().next = l.Tail().Next().Next()
-	l.size--
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	return true
 }
 
 // Removes the last element from the list.
 //
 // If the list is empty, the operation has no effect.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveLast()
-func (l *CircularSinglyLinkedList[T]) RemoveLast() {
+func (l *CircularSinglyLinkedList[T]) RemoveLast() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
+	node := l.Tail()
 	if l.Size() == 1 {
-		l.Clear()
-		return
+		l.reset()
+	} else {
+		current := l.Head()
+		for current.Next() != node {
+			current = current.Next()
+		}
+		current.next = node.Next()
+		l.tail = current
+		l.size--
 	}
-	current := l.Head()
-	for current.Next() != l.Tail() {
-		current = current.Next()
+	if l.observer != nil {
+		l.observer.OnRemove(l.size, node.Value())
 	}
-	current.next = l.Tail().Next()
-	l.tail = current
-	l.size--
+	return true
 }
 
 // Deletes the first occurrence of the specified value from the list.
@@ -282,32 +608,339 @@ func (l *CircularSinglyLinkedList[T]) RemoveLast() {
 // Parameters:
 //   - value: The value to remove.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the value was not found.
+//
 // Example:
 //
 //	list.Remove(10)
-func (l *CircularSinglyLinkedList[T]) Remove(value T) {
+func (l *CircularSinglyLinkedList[T]) Remove(value T) bool {
+	if l.observer != nil {
+		return l.removeObserved(value)
+	}
 	if l.IsEmpty() {
-		return
+		return false
 	}
 	current := l.Tail().Next()
 	prev := l.Tail()
 	for range l.Size() {
 		if current.Value() == value {
 			if l.Size() == 1 {
-				l.Clear()
-				return
-			} else {
-				prev.next = current.Next()
-				if current == l.Tail() {
-					l.tail = prev
-				}
+				l.reset()
+				return true
+			}
+			prev.next = current.Next()
+			if current == l.Tail() {
+				l.tail = prev
 			}
 			l.size--
-			return
+			return true
 		}
 		prev = current
 		current = current.Next()
 	}
+	return false
+}
+
+// Removes and returns the element at the specified index.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//
+// Returns:
+//   - T: The removed value, or the zero value on error.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := list.RemoveAt(1)
+func (l *CircularSinglyLinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.Size() {
+		var zero T
+		return zero, newIndexError("RemoveAt", index, l.Size())
+	}
+	prev := l.Tail()
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	node := l.unlinkAfter(prev)
+	if l.observer != nil {
+		l.observer.OnRemove(index, node.Value())
+	}
+	return node.Value(), nil
+}
+
+// Deletes every occurrence of the specified value from the list.
+//
+// Parameters:
+//   - value: The value to remove.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveAll(10)
+func (l *CircularSinglyLinkedList[T]) RemoveAll(value T) int {
+	return l.RemoveIf(func(v T) bool { return v == value })
+}
+
+// Deletes every element for which the predicate returns true, in a single
+// pass around the ring.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be removed.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveIf(func(v int) bool { return v%2 == 0 })
+func (l *CircularSinglyLinkedList[T]) RemoveIf(predicate func(T) bool) int {
+	removed, index := 0, 0
+	prev := l.Tail()
+	for range l.Size() {
+		current := prev.Next()
+		if predicate(current.Value()) {
+			l.unlinkAfter(prev)
+			removed++
+			if l.observer != nil {
+				l.observer.OnRemove(index, current.Value())
+			}
+		} else {
+			prev = current
+			index++
+		}
+	}
+	return removed
+}
+
+// Keeps only the elements for which the predicate returns true.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be kept.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Retain(func(v int) bool { return v > 0 })
+func (l *CircularSinglyLinkedList[T]) Retain(predicate func(T) bool) int {
+	return l.RemoveIf(func(v T) bool { return !predicate(v) })
+}
+
+// Removes every element equal to an earlier one, keeping the first
+// occurrence, in a single pass that tracks seen values in a set.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Unique()
+func (l *CircularSinglyLinkedList[T]) Unique() int {
+	seen := make(map[T]struct{}, l.Size())
+	return l.RemoveIf(func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+}
+
+// Removes every element that the equality function reports as equal to an
+// earlier one, keeping the first occurrence.
+//
+// Since no set can be built from an arbitrary equality, this runs in O(n*k)
+// where k is the number of distinct elements.
+//
+// Parameters:
+//   - equal: Function reporting whether two values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.UniqueFunc(strings.EqualFold)
+func (l *CircularSinglyLinkedList[T]) UniqueFunc(equal func(a, b T) bool) int {
+	var kept []T
+	return l.RemoveIf(func(v T) bool {
+		for _, k := range kept {
+			if equal(k, v) {
+				return true
+			}
+		}
+		kept = append(kept, v)
+		return false
+	})
+}
+
+// Collapses runs of adjacent equal elements into a single element, like
+// slices.Compact.
+//
+// The comparison does not wrap around: the head is never compared with the
+// tail.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Compact()
+func (l *CircularSinglyLinkedList[T]) Compact() int {
+	return l.CompactFunc(func(a, b T) bool { return a == b })
+}
+
+// Collapses runs of adjacent elements that the equality function reports as
+// equal, keeping the first element of each run, like slices.CompactFunc.
+//
+// The comparison does not wrap around: the head is never compared with the
+// tail.
+//
+// Parameters:
+//   - equal: Function reporting whether two adjacent values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.CompactFunc(strings.EqualFold)
+func (l *CircularSinglyLinkedList[T]) CompactFunc(equal func(a, b T) bool) int {
+	var last T
+	started := false
+	return l.RemoveIf(func(v T) bool {
+		if started && equal(last, v) {
+			return true
+		}
+		last, started = v, true
+		return false
+	})
+}
+
+// Splits the list into the elements that satisfy the predicate and those that
+// do not, preserving their relative order.
+//
+// The nodes are relinked into the two results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element belongs to the first list.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: The elements for which predicate returned true.
+//   - *CircularSinglyLinkedList[T]: The remaining elements.
+//
+// Example:
+//
+//	evens, odds := list.Partition(func(v int) bool { return v%2 == 0 })
+func (l *CircularSinglyLinkedList[T]) Partition(predicate func(T) bool) (*CircularSinglyLinkedList[T], *CircularSinglyLinkedList[T]) {
+	matched, rest := NewCircularSinglyLinkedList[T](), NewCircularSinglyLinkedList[T]()
+	for !l.IsEmpty() {
+		if predicate(l.first()) {
+			l.moveFirstTo(matched)
+		} else {
+			l.moveFirstTo(rest)
+		}
+	}
+	return matched, rest
+}
+
+// Splits the list into the elements less than, equal to and greater than the
+// pivot, preserving their relative order within each part.
+//
+// The nodes are relinked into the three results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - pivot: The value to partition around.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: The elements less than pivot.
+//   - *CircularSinglyLinkedList[T]: The elements equal to pivot.
+//   - *CircularSinglyLinkedList[T]: The elements greater than pivot.
+//
+// Example:
+//
+//	less, equal, greater := list.PartitionAround(5, cmp.Compare[int])
+func (l *CircularSinglyLinkedList[T]) PartitionAround(pivot T, cmp func(x, y T) int) (*CircularSinglyLinkedList[T], *CircularSinglyLinkedList[T], *CircularSinglyLinkedList[T]) {
+	less, equal, greater := NewCircularSinglyLinkedList[T](), NewCircularSinglyLinkedList[T](), NewCircularSinglyLinkedList[T]()
+	for !l.IsEmpty() {
+		switch c := cmp(l.first(), pivot); {
+		case c < 0:
+			l.moveFirstTo(less)
+		case c > 0:
+			l.moveFirstTo(greater)
+		default:
+			l.moveFirstTo(equal)
+		}
+	}
+	return less, equal, greater
+}
+
+// Deletes the elements in the half-open range [from, to).
+//
+// Parameters:
+//   - from: Index of the first element to remove.
+//   - to: Index one past the last element to remove.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.RemoveRange(1, 3)
+func (l *CircularSinglyLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange("RemoveRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	if to-from == l.Size() {
+		l.Clear()
+		return nil
+	}
+	prev := l.Tail()
+	if from > 0 {
+		prev = l.nodeAt(from - 1)
+	}
+	first := prev.Next()
+	last := first
+	for range to - from - 1 {
+		last = last.Next()
+	}
+	prev.next = last.Next()
+	if last == l.Tail() {
+		l.tail = prev
+	}
+	l.size -= to - from
+	if l.observer != nil {
+		notifySinglyRemoved(l.observer, from, first, to-from)
+	}
+	return nil
+}
+
+// Unlinks the node following prev and returns it, keeping the ring closed.
+//
+// The list must not be empty.
+func (l *CircularSinglyLinkedList[T]) unlinkAfter(prev *SinglyLinkedNode[T]) *SinglyLinkedNode[T] {
+	node := prev.Next()
+	if l.Size() == 1 {
+		l.reset()
+		return node
+	}
+	prev.next = node.Next()
+	if node == l.Tail() {
+		l.tail = prev
+	}
+	l.size--
+	return node
 }
 
 // Returns a string representation of the list.
@@ -319,19 +952,72 @@ func (l *CircularSinglyLinkedList[T]) Remove(value T) {
 //
 //	fmt.Println(list.String()) // CircularSinglyLinkedList: [1] -> [2] -> [3]
 func (l *CircularSinglyLinkedList[T]) String() string {
-	if l.IsEmpty() {
-		return "CircularSinglyLinkedList: []"
+	return listString(l.layout())
+}
+
+// Implements fmt.Formatter.
+//
+// The verbs %v and %s print the compact form returned by String, %+v also
+// reports the size and the head and tail values, and %#v prints Go syntax that
+// rebuilds the list, such as list.CircularSinglyLinkedListOf[int](1, 2). Any
+// other verb is applied to each element, along with the # flag. A precision
+// (or, failing that, a width) limits how many elements are printed, replacing
+// the rest with an ellipsis.
+//
+// Parameters:
+//   - f: The formatter state.
+//   - verb: The formatting verb.
+//
+// Example:
+//
+//	fmt.Printf("%.2v\n", list) // CircularSinglyLinkedList: [0] -> [1] -> ...
+func (l *CircularSinglyLinkedList[T]) Format(f fmt.State, verb rune) {
+	formatList(f, verb, l.layout())
+}
+
+// Streams the compact representation of the list to w without building the
+// whole string in memory.
+//
+// Parameters:
+//   - w: The destination writer.
+//
+// Returns:
+//   - int64: Number of bytes written.
+//   - error: The first error returned by w, if any.
+//
+// Example:
+//
+//	_, err := list.WriteTo(os.Stdout)
+func (l *CircularSinglyLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeList(w, l.layout(), defaultFormatOptions)
+}
+
+// Describes the list for the shared formatting helpers.
+func (l *CircularSinglyLinkedList[T]) layout() listLayout[T] {
+	layout := listLayout[T]{
+		name:      "CircularSinglyLinkedList",
+		separator: " -> ",
+		size:      l.Size(),
+		values:    l.values(),
 	}
-	result := "CircularSinglyLinkedList: "
-	current := l.Head()
-	for i := range l.Size() {
-		result += fmt.Sprintf("[%v]", current.Value())
-		if i < l.Size()-1 {
-			result += " -> "
+	if !l.IsEmpty() {
+		layout.head = l.Head().Value()
+		layout.tail = l.Tail().Value()
+	}
+	return layout
+}
+
+// Returns an iterator over the values of the list, from head to tail.
+func (l *CircularSinglyLinkedList[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := l.Head()
+		for range l.Size() {
+			if !yield(current.Value()) {
+				return
+			}
+			current = current.Next()
 		}
-		current = current.Next()
 	}
-	return result
 }
 
 // Inserts a new element at the specified index.
@@ -341,14 +1027,14 @@ func (l *CircularSinglyLinkedList[T]) String() string {
 //   - value: The value to insert.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	err := list.InsertAt(2, 99)
 func (l *CircularSinglyLinkedList[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > l.Size() {
-		return fmt.Errorf("index %d out of bounds", index)
+		return newIndexError("InsertAt", index, l.Size())
 	}
 	if index == 0 {
 		l.Prepend(value)
@@ -366,6 +1052,9 @@ func (l *CircularSinglyLinkedList[T]) InsertAt(index int, value T) error {
 	newNode.next = current.Next()
 	current.next = newNode
 	l.size++
+	if l.observer != nil {
+		l.observer.OnInsert(index, value)
+	}
 	return nil
 }
 
@@ -376,20 +1065,27 @@ func (l *CircularSinglyLinkedList[T]) InsertAt(index int, value T) error {
 //
 // Returns:
 //   - *SinglyLinkedNode[T]: Pointer to the node.
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	node, err := list.Get(1)
 func (l *CircularSinglyLinkedList[T]) Get(index int) (*SinglyLinkedNode[T], error) {
 	if index < 0 || index >= l.Size() {
-		return nil, fmt.Errorf("index %d out of bounds", index)
+		return nil, newIndexError("Get", index, l.Size())
 	}
+	return l.nodeAt(index), nil
+}
+
+// Returns the node at the given index.
+//
+// The index must be within bounds.
+func (l *CircularSinglyLinkedList[T]) nodeAt(index int) *SinglyLinkedNode[T] {
 	current := l.Head()
 	for range index {
 		current = current.Next()
 	}
-	return current, nil
+	return current
 }
 
 // Updates the value of the node at the specified index.
@@ -399,17 +1095,21 @@ func (l *CircularSinglyLinkedList[T]) Get(index int) (*SinglyLinkedNode[T], erro
 //   - value: New value to set.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	err := list.Set(0, 42)
 func (l *CircularSinglyLinkedList[T]) Set(index int, value T) error {
-	node, err := l.Get(index)
-	if err != nil {
-		return err
+	if index < 0 || index >= l.Size() {
+		return newIndexError("Set", index, l.Size())
 	}
+	node := l.nodeAt(index)
+	old := node.Value()
 	node.SetValue(value)
+	if l.observer != nil {
+		l.observer.OnSet(index, old, value)
+	}
 	return nil
 }
 
@@ -433,6 +1133,97 @@ func (l *CircularSinglyLinkedList[T]) Reverse() {
 	}
 	head.SetNext(prev)
 	l.tail = head
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the order of the elements in the half-open range [from, to) by
+// relinking their nodes in place.
+//
+// Parameters:
+//   - from: Index of the first element to reverse.
+//   - to: Index one past the last element to reverse.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.ReverseRange(1, 4)
+func (l *CircularSinglyLinkedList[T]) ReverseRange(from, to int) error {
+	if err := checkRange("ReverseRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if to-from < 2 {
+		return nil
+	}
+	if to-from == l.Size() {
+		l.Reverse()
+		return nil
+	}
+	prev := l.Tail()
+	if from > 0 {
+		prev = l.nodeAt(from - 1)
+	}
+	l.reverseAfter(prev, to-from)
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+	return nil
+}
+
+// Reverses every consecutive group of k elements in place, starting at the
+// head. A trailing group with fewer than k elements is reversed as well.
+//
+// Parameters:
+//   - k: The size of each group. ReverseGroups panics if k is less than 1.
+//
+// Example:
+//
+//	list.ReverseGroups(2) // [1 2 3 4 5] becomes [2 1 4 3 5]
+func (l *CircularSinglyLinkedList[T]) ReverseGroups(k int) {
+	if k < 1 {
+		panic("list: group size cannot be less than 1")
+	}
+	if k >= l.Size() {
+		l.Reverse()
+		return
+	}
+	prev := l.Tail()
+	for remaining := l.Size(); remaining > 0; remaining -= k {
+		prev = l.reverseAfter(prev, min(k, remaining))
+	}
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the count nodes following prev and returns the node that now ends
+// the reversed segment, keeping the ring closed.
+//
+// count must be at least 1 and less than the size of the list, so that prev
+// lies outside the segment.
+func (l *CircularSinglyLinkedList[T]) reverseAfter(prev *SinglyLinkedNode[T], count int) *SinglyLinkedNode[T] {
+	first := prev.Next()
+	var reversed *SinglyLinkedNode[T]
+	current := first
+	containsTail := false
+	for range count {
+		if current == l.Tail() {
+			containsTail = true
+		}
+		next := current.Next()
+		current.next = reversed
+		reversed = current
+		current = next
+	}
+	first.next = current
+	prev.next = reversed
+	if containsTail {
+		l.tail = first
+	}
+	return first
 }
 
 // Reports whether the list contains the specified value.
@@ -468,3 +1259,33 @@ func (l *CircularSinglyLinkedList[T]) ForEach(action func(T)) {
 		current = current.Next()
 	}
 }
+
+// Returns an iterator over the elements of the list, from head to tail.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range list.All() {
+//	    fmt.Println(v)
+//	}
+func (l *CircularSinglyLinkedList[T]) All() iter.Seq[T] {
+	return l.values()
+}
+
+// Returns a slice containing all elements of the list.
+//
+// Returns:
+//   - []T: Slice of all elements, from head to tail.
+//
+// Example:
+//
+//	slice := list.ToSlice()
+func (l *CircularSinglyLinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, l.Size())
+	for value := range l.values() {
+		result = append(result, value)
+	}
+	return result
+}
@@ -1,6 +1,11 @@
 package list
 
-import "testing"
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+)
 
 func TestCircularSinglyLinkedListNewCircularSinglyLinkedList(t *testing.T) {
 	list := NewCircularSinglyLinkedList[int]()
@@ -436,3 +441,377 @@ func TestCircularSinglyLinkedListInsertAtMiddleMultiple(t *testing.T) {
 		current = current.Next()
 	}
 }
+
+func TestCircularSinglyLinkedListRemoveReportsResult(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	if list.RemoveFirst() || list.RemoveLast() || list.Remove(1) {
+		t.Error("expected removals on empty list to report false")
+	}
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	if !list.Remove(2) {
+		t.Error("expected Remove(2) to report true")
+	}
+	if list.Remove(2) {
+		t.Error("expected second Remove(2) to report false")
+	}
+	if !list.RemoveFirst() || !list.RemoveLast() {
+		t.Error("expected RemoveFirst and RemoveLast to report true")
+	}
+	if !list.IsEmpty() {
+		t.Errorf("expected empty list, got size %d", list.Size())
+	}
+}
+
+func TestCircularSinglyLinkedListRemoveAt(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	for i := range 4 {
+		list.Append(i)
+	}
+	value, err := list.RemoveAt(3)
+	if err != nil || value != 3 {
+		t.Fatalf("expected 3, nil; got %v, %v", value, err)
+	}
+	value, err = list.RemoveAt(0)
+	if err != nil || value != 0 {
+		t.Fatalf("expected 0, nil; got %v, %v", value, err)
+	}
+	if got := slices.Collect(list.values()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+	if list.Tail().Next() != list.Head() {
+		t.Error("expected tail to link back to head")
+	}
+	if _, err := list.RemoveAt(2); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestCircularSinglyLinkedListRemoveIfAndRetain(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	for _, v := range []int{1, 2, 1, 3, 1} {
+		list.Append(v)
+	}
+	if removed := list.RemoveAll(1); removed != 3 {
+		t.Errorf("expected 3 removed, got %d", removed)
+	}
+	if got := slices.Collect(list.values()); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("expected [2 3], got %v", got)
+	}
+	if list.Tail().Value() != 3 || list.Tail().Next() != list.Head() {
+		t.Error("expected ring to stay closed with tail 3")
+	}
+	if removed := list.Retain(func(int) bool { return false }); removed != 2 {
+		t.Errorf("expected 2 removed, got %d", removed)
+	}
+	if !list.IsEmpty() || list.Head() != nil {
+		t.Error("expected list to be empty")
+	}
+}
+
+func TestCircularSinglyLinkedListRemoveRange(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	for i := range 6 {
+		list.Append(i)
+	}
+	if err := list.RemoveRange(3, 6); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := list.RemoveRange(0, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := slices.Collect(list.values()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+	if list.Tail().Value() != 2 || list.Tail().Next() != list.Head() {
+		t.Error("expected ring to stay closed with tail 2")
+	}
+	if err := list.RemoveRange(0, 2); err != nil || !list.IsEmpty() {
+		t.Errorf("expected empty list, got %v with error %v", list, err)
+	}
+	if err := list.RemoveRange(0, 1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestCircularSinglyLinkedListSearchByPosition(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	if list.IndexOf(1) != -1 || list.LastIndexOf(1) != -1 || list.FindFunc(func(int) bool { return true }) != nil {
+		t.Error("expected searches on empty list to find nothing")
+	}
+	for _, v := range []int{4, 1, 5, 1, 9} {
+		list.Append(v)
+	}
+	if index := list.IndexOf(1); index != 1 {
+		t.Errorf("expected IndexOf(1) = 1, got %d", index)
+	}
+	if index := list.LastIndexOf(1); index != 3 {
+		t.Errorf("expected LastIndexOf(1) = 3, got %d", index)
+	}
+	if index := list.LastIndexOf(4); index != 0 {
+		t.Errorf("expected LastIndexOf(4) = 0, got %d", index)
+	}
+	if index := list.IndexOf(7); index != -1 {
+		t.Errorf("expected IndexOf(7) = -1, got %d", index)
+	}
+	if count := list.Count(1); count != 2 {
+		t.Errorf("expected Count(1) = 2, got %d", count)
+	}
+}
+
+func TestCircularSinglyLinkedListSearchByPredicate(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	for _, v := range []int{4, 1, 5, 1, 9} {
+		list.Append(v)
+	}
+	greaterThan3 := func(v int) bool { return v > 3 }
+	if node := list.FindFunc(greaterThan3); node != list.Head() {
+		t.Errorf("expected FindFunc to return the head, got %v", node)
+	}
+	if node := list.FindLast(greaterThan3); node != list.Tail() {
+		t.Errorf("expected FindLast to return the tail, got %v", node)
+	}
+	if node := list.FindLast(func(v int) bool { return v > 100 }); node != nil {
+		t.Errorf("expected FindLast to return nil, got %v", node)
+	}
+	nodes := list.FindAll(greaterThan3)
+	values := []int{}
+	for _, node := range nodes {
+		values = append(values, node.Value())
+	}
+	if !slices.Equal(values, []int{4, 5, 9}) {
+		t.Errorf("expected [4 5 9], got %v", values)
+	}
+	if nodes := list.FindAll(func(v int) bool { return v < 0 }); nodes != nil {
+		t.Errorf("expected nil, got %v", nodes)
+	}
+}
+
+func TestCircularSinglyLinkedListOfAndFromSlice(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3)
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", list.ToSlice())
+	}
+	if list.Size() != 3 || list.Tail().Value() != 3 {
+		t.Errorf("expected size 3 and tail 3, got %d and %v", list.Size(), list.Tail().Value())
+	}
+	source := []int{4, 5}
+	list = CircularSinglyLinkedListFromSlice(source)
+	source[0] = 99
+	if !slices.Equal(list.ToSlice(), []int{4, 5}) {
+		t.Errorf("expected [4 5], got %v", list.ToSlice())
+	}
+	if empty := CircularSinglyLinkedListOf[int](); !empty.IsEmpty() || len(empty.ToSlice()) != 0 {
+		t.Error("expected empty list")
+	}
+}
+
+func TestCircularSinglyLinkedListBatchInsertion(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	list.AppendAll()
+	list.PrependAll()
+	if !list.IsEmpty() {
+		t.Error("expected empty batches to leave the list empty")
+	}
+	list.AppendAll(4, 5)
+	list.PrependAll(1, 2)
+	if err := list.InsertAllAt(2, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := list.InsertAllAt(5, 6, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 3, 4, 5, 6, 7}) {
+		t.Errorf("expected [1 2 3 4 5 6 7], got %v", list.ToSlice())
+	}
+	if list.Size() != 7 || list.Head().Value() != 1 || list.Tail().Value() != 7 {
+		t.Errorf("unexpected size, head or tail: %+v", list)
+	}
+	list.Append(8)
+	if list.Tail().Value() != 8 {
+		t.Errorf("expected tail 8 after Append, got %v", list.Tail().Value())
+	}
+	if err := list.InsertAllAt(10, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestCircularSinglyLinkedListUniqueAndUniqueFunc(t *testing.T) {
+	list := CircularSinglyLinkedListOf(3, 1, 3, 2, 1, 3)
+	if removed := list.Unique(); removed != 3 {
+		t.Errorf("expected 3 removed, got %d", removed)
+	}
+	if !slices.Equal(list.ToSlice(), []int{3, 1, 2}) {
+		t.Errorf("expected [3 1 2], got %v", list.ToSlice())
+	}
+	if list.Tail().Value() != 2 {
+		t.Errorf("expected tail 2, got %v", list.Tail().Value())
+	}
+	list = CircularSinglyLinkedListOf(1, 12, 3, 22, 14, 11)
+	sameLastDigit := func(a, b int) bool { return a%10 == b%10 }
+	if removed := list.UniqueFunc(sameLastDigit); removed != 2 {
+		t.Errorf("expected 2 removed, got %d", removed)
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 12, 3, 14}) {
+		t.Errorf("expected [1 12 3 14], got %v", list.ToSlice())
+	}
+}
+
+func TestCircularSinglyLinkedListCompactAndCompactFunc(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 1, 2, 2, 2, 1, 3, 3)
+	if removed := list.Compact(); removed != 4 {
+		t.Errorf("expected 4 removed, got %d", removed)
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 1, 3}) {
+		t.Errorf("expected [1 2 1 3], got %v", list.ToSlice())
+	}
+	if list.Tail().Value() != 3 || list.Size() != 4 {
+		t.Errorf("expected tail 3 and size 4, got %v and %d", list.Tail().Value(), list.Size())
+	}
+	list = CircularSinglyLinkedListOf(10, 11, 20, 25, 31)
+	sameTens := func(a, b int) bool { return a/10 == b/10 }
+	if removed := list.CompactFunc(sameTens); removed != 2 {
+		t.Errorf("expected 2 removed, got %d", removed)
+	}
+	if !slices.Equal(list.ToSlice(), []int{10, 20, 31}) {
+		t.Errorf("expected [10 20 31], got %v", list.ToSlice())
+	}
+	empty := NewCircularSinglyLinkedList[int]()
+	if empty.Compact() != 0 || empty.Unique() != 0 {
+		t.Error("expected nothing removed from empty list")
+	}
+}
+
+func TestCircularSinglyLinkedListPartition(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3, 4, 5, 6)
+	head := list.Head()
+	evens, odds := list.Partition(func(v int) bool { return v%2 == 0 })
+	if !list.IsEmpty() {
+		t.Error("expected receiver to be left empty")
+	}
+	if !slices.Equal(evens.ToSlice(), []int{2, 4, 6}) || !slices.Equal(odds.ToSlice(), []int{1, 3, 5}) {
+		t.Errorf("expected [2 4 6] and [1 3 5], got %v and %v", evens.ToSlice(), odds.ToSlice())
+	}
+	if odds.Head() != head {
+		t.Error("expected nodes to be relinked rather than copied")
+	}
+	if evens.Tail().Value() != 6 || odds.Tail().Value() != 5 {
+		t.Errorf("expected tails 6 and 5, got %v and %v", evens.Tail().Value(), odds.Tail().Value())
+	}
+	odds.Append(7)
+	if !slices.Equal(odds.ToSlice(), []int{1, 3, 5, 7}) {
+		t.Errorf("expected [1 3 5 7], got %v", odds.ToSlice())
+	}
+}
+
+func TestCircularSinglyLinkedListPartitionAround(t *testing.T) {
+	list := CircularSinglyLinkedListOf(5, 1, 8, 5, 3, 9)
+	less, equal, greater := list.PartitionAround(5, cmp.Compare[int])
+	if !slices.Equal(less.ToSlice(), []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", less.ToSlice())
+	}
+	if !slices.Equal(equal.ToSlice(), []int{5, 5}) {
+		t.Errorf("expected [5 5], got %v", equal.ToSlice())
+	}
+	if !slices.Equal(greater.ToSlice(), []int{8, 9}) {
+		t.Errorf("expected [8 9], got %v", greater.ToSlice())
+	}
+	if !list.IsEmpty() {
+		t.Error("expected receiver to be left empty")
+	}
+}
+
+func TestCircularSinglyLinkedListAll(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3)
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+	for v := range list.All() {
+		if v != 1 {
+			t.Errorf("expected to stop after the first element, got %v", v)
+		}
+		break
+	}
+}
+
+func TestCircularSinglyLinkedListReverseRange(t *testing.T) {
+	list := CircularSinglyLinkedListOf(0, 1, 2, 3, 4, 5)
+	if err := list.ReverseRange(1, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(list.ToSlice(), []int{0, 3, 2, 1, 4, 5}) {
+		t.Errorf("expected [0 3 2 1 4 5], got %v", list.ToSlice())
+	}
+	if err := list.ReverseRange(0, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := list.ReverseRange(3, 6); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(list.ToSlice(), []int{3, 0, 2, 5, 4, 1}) {
+		t.Errorf("expected [3 0 2 5 4 1], got %v", list.ToSlice())
+	}
+	if list.Head().Value() != 3 || list.Tail().Value() != 1 {
+		t.Errorf("expected head 3 and tail 1, got %v and %v", list.Head().Value(), list.Tail().Value())
+	}
+	if err := list.ReverseRange(0, 6); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 4, 5, 2, 0, 3}) {
+		t.Errorf("expected [1 4 5 2 0 3], got %v", list.ToSlice())
+	}
+	list.Append(6)
+	if list.Tail().Value() != 6 || list.Size() != 7 {
+		t.Errorf("expected tail 6 and size 7, got %v and %d", list.Tail().Value(), list.Size())
+	}
+	if err := list.ReverseRange(2, 8); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestCircularSinglyLinkedListReverseGroups(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3, 4, 5, 6, 7)
+	list.ReverseGroups(3)
+	if !slices.Equal(list.ToSlice(), []int{3, 2, 1, 6, 5, 4, 7}) {
+		t.Errorf("expected [3 2 1 6 5 4 7], got %v", list.ToSlice())
+	}
+	list.ReverseGroups(2)
+	if !slices.Equal(list.ToSlice(), []int{2, 3, 6, 1, 4, 5, 7}) {
+		t.Errorf("expected [2 3 6 1 4 5 7], got %v", list.ToSlice())
+	}
+	list.ReverseGroups(4)
+	if !slices.Equal(list.ToSlice(), []int{1, 6, 3, 2, 7, 5, 4}) {
+		t.Errorf("expected [1 6 3 2 7 5 4], got %v", list.ToSlice())
+	}
+	if list.Head().Value() != 1 || list.Tail().Value() != 4 {
+		t.Errorf("expected head 1 and tail 4, got %v and %v", list.Head().Value(), list.Tail().Value())
+	}
+	list.ReverseGroups(10)
+	if !slices.Equal(list.ToSlice(), []int{4, 5, 7, 2, 3, 6, 1}) {
+		t.Errorf("expected [4 5 7 2 3 6 1], got %v", list.ToSlice())
+	}
+	empty := NewCircularSinglyLinkedList[int]()
+	empty.ReverseGroups(2)
+	if !empty.IsEmpty() {
+		t.Error("expected empty list to stay empty")
+	}
+}
+
+func TestCircularSinglyLinkedListReverseRangeKeepsRingClosed(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3, 4, 5)
+	if err := list.ReverseRange(2, 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list.ReverseGroups(2)
+	if list.Tail().Next() != list.Head() {
+		t.Error("expected tail to link back to head")
+	}
+	current := list.Head()
+	for range list.Size() {
+		current = current.Next()
+	}
+	if current != list.Head() {
+		t.Error("expected a full walk to return to the head")
+	}
+}
@@ -0,0 +1,98 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSinglyLinkedListClone(t *testing.T) {
+	list := SinglyLinkedListOf(1, 2, 3)
+	clone := list.Clone()
+	if !slices.Equal(clone.ToSlice(), []int{1, 2, 3}) || clone.Size() != 3 {
+		t.Fatalf("expected [1 2 3], got %v", clone.ToSlice())
+	}
+	for a, b := list.Head(), clone.Head(); a != nil; a, b = a.Next(), b.Next() {
+		if a == b {
+			t.Fatalf("expected no shared nodes, node %d is shared", a.Value())
+		}
+	}
+	if clone.Tail().Next() != nil {
+		t.Error("expected the clone's tail to be terminated")
+	}
+	clone.Append(4)
+	if list.Size() != 3 {
+		t.Errorf("expected the original to be unchanged, got %v", list.ToSlice())
+	}
+	if empty := NewSinglyLinkedList[int]().Clone(); !empty.IsEmpty() || empty.Head() != nil {
+		t.Error("expected the clone of an empty list to be empty")
+	}
+}
+
+func TestDoublyLinkedListClone(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3)
+	clone := list.Clone()
+	seen := make(map[*DoublyLinkedNode[int]]bool)
+	for node := list.Head(); node != nil; node = node.Next() {
+		seen[node] = true
+	}
+	for node := clone.Tail(); node != nil; node = node.Prev() {
+		if seen[node] {
+			t.Fatalf("expected no shared nodes, node %d is shared", node.Value())
+		}
+	}
+	if clone.Head().Prev() != nil || clone.Tail().Value() != 3 {
+		t.Error("expected the clone to be a well-formed linear list")
+	}
+	clone.Head().SetValue(10)
+	if list.Head().Value() != 1 {
+		t.Errorf("expected the original head to stay 1, got %d", list.Head().Value())
+	}
+}
+
+func TestCircularListsClone(t *testing.T) {
+	singly := CircularSinglyLinkedListOf(1, 2, 3)
+	singlyClone := singly.Clone()
+	if singlyClone.Tail().Next() != singlyClone.Head() {
+		t.Error("expected the clone to stay circular")
+	}
+	node, cloned := singly.Head(), singlyClone.Head()
+	for range singly.Size() {
+		if node == cloned {
+			t.Fatalf("expected no shared nodes, node %d is shared", node.Value())
+		}
+		node, cloned = node.Next(), cloned.Next()
+	}
+
+	doubly := CircularDoublyLinkedListOf(1, 2, 3)
+	doublyClone := doubly.Clone()
+	checkCircularDoublyLinks(t, doublyClone, []int{1, 2, 3})
+	for node := range doubly.Size() {
+		a, _ := doubly.Get(node)
+		b, _ := doublyClone.Get(node)
+		if a == b {
+			t.Fatalf("expected no shared nodes, node %d is shared", a.Value())
+		}
+	}
+}
+
+func TestCloneFuncDeepCopiesValues(t *testing.T) {
+	type item struct{ count int }
+	list := CircularDoublyLinkedListOf(&item{1}, &item{2})
+	clone := list.CloneFunc(func(p *item) *item {
+		copied := *p
+		return &copied
+	})
+	clone.Head().Value().count = 100
+	if list.Head().Value().count != 1 {
+		t.Errorf("expected the original value to be unchanged, got %d", list.Head().Value().count)
+	}
+	shallow := list.Clone()
+	if shallow.Head().Value() != list.Head().Value() {
+		t.Error("expected Clone to copy pointer values as is")
+	}
+
+	doubled := SinglyLinkedListOf(1, 2, 3).CloneFunc(func(v int) int { return v * 2 })
+	if !slices.Equal(doubled.ToSlice(), []int{2, 4, 6}) {
+		t.Errorf("expected [2 4 6], got %v", doubled.ToSlice())
+	}
+}
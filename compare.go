@@ -0,0 +1,276 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"cmp"
+	"encoding/binary"
+	"hash/maphash"
+	"iter"
+)
+
+// Reports whether two lists hold equal values in the same order.
+//
+// The lists may be of different kinds: a SinglyLinkedList and a
+// CircularDoublyLinkedList with the same values are equal. Circular lists are
+// compared from their heads, so rotations of the same ring are not equal.
+//
+// Parameters:
+//   - a: The first list.
+//   - b: The second list.
+//
+// Returns:
+//   - bool: True if both lists have the same size and equal values pairwise.
+//
+// Example:
+//
+//	list.Equal(list.SinglyLinkedListOf(1, 2), list.DoublyLinkedListOf(1, 2)) // true
+func Equal[LA AnyList[T], LB AnyList[T], T comparable](a LA, b LB) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// Reports whether two lists are equal using eq to compare values pairwise.
+//
+// Parameters:
+//   - a: The first list.
+//   - b: The second list.
+//   - eq: Reports whether an element of a matches the element of b at the
+//     same position.
+//
+// Returns:
+//   - bool: True if both lists have the same size and eq holds pairwise.
+//
+// Example:
+//
+//	same := list.EqualFunc(names, lengths, func(s string, n int) bool {
+//	    return len(s) == n
+//	})
+func EqualFunc[LA AnyList[A], LB AnyList[B], A, B comparable](a LA, b LB, eq func(A, B) bool) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+	next, stop := iter.Pull(b.values())
+	defer stop()
+	for x := range a.values() {
+		y, _ := next()
+		if !eq(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compares two lists lexicographically.
+//
+// Elements are compared pairwise with cmp.Compare; the first difference
+// decides the result. If one list is a prefix of the other, the shorter list
+// is the smaller one.
+//
+// Parameters:
+//   - a: The first list.
+//   - b: The second list.
+//
+// Returns:
+//   - int: -1 if a < b, 0 if a == b and +1 if a > b.
+//
+// Example:
+//
+//	list.Compare(list.SinglyLinkedListOf(1, 2), list.DoublyLinkedListOf(1, 3)) // -1
+func Compare[LA AnyList[T], LB AnyList[T], T cmp.Ordered](a LA, b LB) int {
+	next, stop := iter.Pull(b.values())
+	defer stop()
+	for x := range a.values() {
+		y, ok := next()
+		if !ok {
+			return 1
+		}
+		if c := cmp.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+	if _, ok := next(); ok {
+		return -1
+	}
+	return 0
+}
+
+// Computes a hash of the list's values in order.
+//
+// The hash depends only on the values and the seed, not on the list kind, so
+// lists that are Equal hash to the same value. As with maphash, hashes are
+// only comparable when computed with the same seed.
+//
+// Parameters:
+//   - seed: The maphash seed.
+//   - l: The list to hash.
+//
+// Returns:
+//   - uint64: The hash of the list.
+//
+// Example:
+//
+//	seed := maphash.MakeSeed()
+//	h := list.Hash(seed, l)
+func Hash[L AnyList[T], T comparable](seed maphash.Seed, l L) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeUint64(&h, uint64(l.Size()))
+	for value := range l.values() {
+		maphash.WriteComparable(&h, value)
+	}
+	return h.Sum64()
+}
+
+// Computes a hash of a circular list that does not depend on which node is
+// the head.
+//
+// All rotations of the same ring hash to the same value, regardless of list
+// kind. The result is not comparable with Hash.
+//
+// Parameters:
+//   - seed: The maphash seed.
+//   - l: The circular list to hash.
+//
+// Returns:
+//   - uint64: The rotation-invariant hash of the list.
+//
+// Example:
+//
+//	a := list.CircularSinglyLinkedListOf(1, 2, 3)
+//	b := list.CircularDoublyLinkedListOf(2, 3, 1)
+//	list.HashRotationInvariant(seed, a) == list.HashRotationInvariant(seed, b) // true
+func HashRotationInvariant[L CircularList[T], T comparable](seed maphash.Seed, l L) uint64 {
+	hashes := make([]uint64, 0, l.Size())
+	for value := range l.values() {
+		hashes = append(hashes, maphash.Comparable(seed, value))
+	}
+
+	// Hash the element hashes starting from their least rotation, which is
+	// the same for every rotation of the ring.
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeUint64(&h, uint64(len(hashes)))
+	start := leastRotation(hashes)
+	for i := range hashes {
+		writeUint64(&h, hashes[(start+i)%len(hashes)])
+	}
+	return h.Sum64()
+}
+
+// Writes v to h in a fixed byte order.
+func writeUint64(h *maphash.Hash, v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	h.Write(buf[:])
+}
+
+// Returns the start index of the lexicographically least rotation of s in
+// O(n), by advancing two candidate starts past each mismatch.
+func leastRotation(s []uint64) int {
+	n := len(s)
+	i, j, k := 0, 1, 0
+	for i < n && j < n && k < n {
+		a, b := s[(i+k)%n], s[(j+k)%n]
+		if a == b {
+			k++
+			continue
+		}
+		if a > b {
+			i += k + 1
+		} else {
+			j += k + 1
+		}
+		if i == j {
+			j++
+		}
+		k = 0
+	}
+	return min(i, j)
+}
@@ -0,0 +1,98 @@
+package list
+
+import (
+	"hash/maphash"
+	"slices"
+	"testing"
+)
+
+func TestEqualAcrossKinds(t *testing.T) {
+	if !Equal(SinglyLinkedListOf(1, 2, 3), CircularDoublyLinkedListOf(1, 2, 3)) {
+		t.Error("expected lists with the same values to be equal")
+	}
+	if Equal(DoublyLinkedListOf(1, 2, 3), DoublyLinkedListOf(1, 2)) {
+		t.Error("expected lists of different sizes to differ")
+	}
+	if Equal(CircularSinglyLinkedListOf(1, 2, 3), CircularSinglyLinkedListOf(2, 3, 1)) {
+		t.Error("expected rotations to differ")
+	}
+	if !Equal(NewSinglyLinkedList[int](), NewCircularDoublyLinkedList[int]()) {
+		t.Error("expected empty lists to be equal")
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	words := DoublyLinkedListOf("a", "bb", "ccc")
+	lengths := CircularSinglyLinkedListOf(1, 2, 3)
+	if !EqualFunc(words, lengths, func(s string, n int) bool { return len(s) == n }) {
+		t.Error("expected EqualFunc to match lengths")
+	}
+	if EqualFunc(words, SinglyLinkedListOf(1, 2, 4), func(s string, n int) bool { return len(s) == n }) {
+		t.Error("expected EqualFunc to detect a mismatch")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b     []int
+		expected int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{1, 2}, []int{1, 3}, -1},
+		{[]int{2}, []int{1, 9}, 1},
+		{[]int{1, 2}, []int{1, 2, 0}, -1},
+		{[]int{1, 2, 0}, []int{1, 2}, 1},
+		{nil, nil, 0},
+	}
+	for _, test := range tests {
+		got := Compare(SinglyLinkedListFromSlice(test.a), CircularDoublyLinkedListFromSlice(test.b))
+		if got != test.expected {
+			t.Errorf("Compare(%v, %v): expected %d, got %d", test.a, test.b, test.expected, got)
+		}
+	}
+}
+
+func TestHashStableAcrossKinds(t *testing.T) {
+	seed := maphash.MakeSeed()
+	h := Hash(seed, SinglyLinkedListOf("a", "b"))
+	if h != Hash(seed, CircularDoublyLinkedListOf("a", "b")) {
+		t.Error("expected equal lists of different kinds to hash equally")
+	}
+	if h == Hash(seed, DoublyLinkedListOf("b", "a")) {
+		t.Error("expected the hash to depend on order")
+	}
+	if Hash(seed, DoublyLinkedListOf("ab")) == Hash(seed, DoublyLinkedListOf("a", "b")) {
+		t.Error("expected element boundaries to affect the hash")
+	}
+}
+
+func TestHashRotationInvariant(t *testing.T) {
+	seed := maphash.MakeSeed()
+	values := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	expected := HashRotationInvariant(seed, CircularSinglyLinkedListFromSlice(values))
+	for i := range values {
+		rotated := slices.Concat(values[i:], values[:i])
+		if got := HashRotationInvariant(seed, CircularDoublyLinkedListFromSlice(rotated)); got != expected {
+			t.Errorf("rotation %v: expected %d, got %d", rotated, expected, got)
+		}
+	}
+	reversed := slices.Clone(values)
+	slices.Reverse(reversed)
+	if HashRotationInvariant(seed, CircularSinglyLinkedListFromSlice(reversed)) == expected {
+		t.Error("expected a reversed ring to hash differently")
+	}
+	if HashRotationInvariant(seed, CircularSinglyLinkedListOf(1, 1, 1)) == HashRotationInvariant(seed, CircularSinglyLinkedListOf(1, 1)) {
+		t.Error("expected rings of different sizes to hash differently")
+	}
+}
+
+func TestLeastRotation(t *testing.T) {
+	s := []uint64{2, 1, 3, 1, 3, 1, 2}
+	start := leastRotation(s)
+	best := slices.Concat(s[start:], s[:start])
+	for i := range s {
+		if rotation := slices.Concat(s[i:], s[:i]); slices.Compare(rotation, best) < 0 {
+			t.Fatalf("rotation %v is smaller than the chosen %v", rotation, best)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+// Converts the list into a circular singly linked list.
+//
+// The existing nodes are reused and the tail is linked back to the head, so the
+// receiver is left empty.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	ring := list.ToCircularSinglyLinkedList()
+func (l *SinglyLinkedList[T]) ToCircularSinglyLinkedList() *CircularSinglyLinkedList[T] {
+	result := NewCircularSinglyLinkedList[T]()
+	if !l.IsEmpty() {
+		l.Tail().next = l.Head()
+		result.tail = l.Tail()
+		result.size = l.Size()
+	}
+	l.Clear()
+	return result
+}
+
+// Converts the list into a doubly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	dlist := list.ToDoublyLinkedList()
+func (l *SinglyLinkedList[T]) ToDoublyLinkedList() *DoublyLinkedList[T] {
+	result := DoublyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a circular doubly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	ring := list.ToCircularDoublyLinkedList()
+func (l *SinglyLinkedList[T]) ToCircularDoublyLinkedList() *CircularDoublyLinkedList[T] {
+	result := CircularDoublyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a singly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	slist := list.ToSinglyLinkedList()
+func (l *DoublyLinkedList[T]) ToSinglyLinkedList() *SinglyLinkedList[T] {
+	result := SinglyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a circular singly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	ring := list.ToCircularSinglyLinkedList()
+func (l *DoublyLinkedList[T]) ToCircularSinglyLinkedList() *CircularSinglyLinkedList[T] {
+	result := CircularSinglyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a circular doubly linked list.
+//
+// The existing nodes are reused and the ends are linked to each other, so the
+// receiver is left empty.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	ring := list.ToCircularDoublyLinkedList()
+func (l *DoublyLinkedList[T]) ToCircularDoublyLinkedList() *CircularDoublyLinkedList[T] {
+	result := NewCircularDoublyLinkedList[T]()
+	if !l.IsEmpty() {
+		l.Tail().SetNext(l.Head())
+		l.Head().SetPrev(l.Tail())
+		result.tail = l.Tail()
+		result.size = l.Size()
+	}
+	l.Clear()
+	return result
+}
+
+// Converts the list into a linear singly linked list.
+//
+// The existing nodes are reused and the ring is broken after the tail, so the
+// receiver is left empty.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	slist := ring.ToSinglyLinkedList()
+func (l *CircularSinglyLinkedList[T]) ToSinglyLinkedList() *SinglyLinkedList[T] {
+	result := NewSinglyLinkedList[T]()
+	head, tail, size := l.Head(), l.Tail(), l.Size()
+	// Cleared while the ring is intact, so the observer can walk it.
+	l.Clear()
+	if tail != nil {
+		tail.SetNext(nil)
+		result.head, result.tail, result.size = head, tail, size
+	}
+	return result
+}
+
+// Converts the list into a doubly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	dlist := ring.ToDoublyLinkedList()
+func (l *CircularSinglyLinkedList[T]) ToDoublyLinkedList() *DoublyLinkedList[T] {
+	result := DoublyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a circular doubly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *CircularDoublyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	cdlist := ring.ToCircularDoublyLinkedList()
+func (l *CircularSinglyLinkedList[T]) ToCircularDoublyLinkedList() *CircularDoublyLinkedList[T] {
+	result := CircularDoublyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a singly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	slist := ring.ToSinglyLinkedList()
+func (l *CircularDoublyLinkedList[T]) ToSinglyLinkedList() *SinglyLinkedList[T] {
+	result := SinglyLinkedListFromSlice(l.ToSlice())
+	return result
+}
+
+// Converts the list into a linear doubly linked list.
+//
+// The existing nodes are reused and the ring is broken between the tail and the
+// head, so the receiver is left empty.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	dlist := ring.ToDoublyLinkedList()
+func (l *CircularDoublyLinkedList[T]) ToDoublyLinkedList() *DoublyLinkedList[T] {
+	result := NewDoublyLinkedList[T]()
+	head, tail, size := l.Head(), l.Tail(), l.Size()
+	// Cleared while the ring is intact, so the observer can walk it.
+	l.Clear()
+	if tail != nil {
+		head.SetPrev(nil)
+		tail.SetNext(nil)
+		result.head, result.tail, result.size = head, tail, size
+	}
+	return result
+}
+
+// Converts the list into a circular singly linked list.
+//
+// New nodes are allocated, and the receiver is left unchanged.
+//
+// Returns:
+//   - *CircularSinglyLinkedList[T]: Pointer to the converted list.
+//
+// Example:
+//
+//	ring := cdlist.ToCircularSinglyLinkedList()
+func (l *CircularDoublyLinkedList[T]) ToCircularSinglyLinkedList() *CircularSinglyLinkedList[T] {
+	result := CircularSinglyLinkedListFromSlice(l.ToSlice())
+	return result
+}
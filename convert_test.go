@@ -0,0 +1,142 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSinglyLinkedListConversions(t *testing.T) {
+	list := SinglyLinkedListOf(1, 2, 3)
+	head := list.Head()
+	ring := list.ToCircularSinglyLinkedList()
+	if !list.IsEmpty() {
+		t.Error("expected source list to be empty")
+	}
+	if ring.Head() != head {
+		t.Error("expected nodes to be reused")
+	}
+	if !slices.Equal(ring.ToSlice(), []int{1, 2, 3}) || ring.Tail().Next() != ring.Head() {
+		t.Errorf("expected closed ring [1 2 3], got %v", ring)
+	}
+	doubly := SinglyLinkedListOf(1, 2).ToDoublyLinkedList()
+	if !slices.Equal(doubly.ToSlice(), []int{1, 2}) || doubly.Tail().Prev() != doubly.Head() {
+		t.Errorf("expected doubly linked [1 2], got %v", doubly)
+	}
+	circular := SinglyLinkedListOf(1, 2).ToCircularDoublyLinkedList()
+	if !slices.Equal(circular.ToSlice(), []int{1, 2}) || circular.Head().Prev() != circular.Tail() {
+		t.Errorf("expected circular doubly linked [1 2], got %v", circular)
+	}
+}
+
+func TestDoublyLinkedListConversions(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3)
+	head := list.Head()
+	ring := list.ToCircularDoublyLinkedList()
+	if !list.IsEmpty() || list.Head() != nil {
+		t.Error("expected source list to be empty")
+	}
+	if ring.Head() != head {
+		t.Error("expected nodes to be reused")
+	}
+	if ring.Head().Prev() != ring.Tail() || ring.Tail().Next() != ring.Head() {
+		t.Error("expected ring to be closed in both directions")
+	}
+	singly := DoublyLinkedListOf(1, 2).ToSinglyLinkedList()
+	if !slices.Equal(singly.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", singly)
+	}
+	circular := DoublyLinkedListOf(1, 2).ToCircularSinglyLinkedList()
+	if !slices.Equal(circular.ToSlice(), []int{1, 2}) || circular.Tail().Next() != circular.Head() {
+		t.Errorf("expected closed ring [1 2], got %v", circular)
+	}
+}
+
+func TestCircularSinglyLinkedListConversions(t *testing.T) {
+	ring := CircularSinglyLinkedListOf(1, 2, 3)
+	head := ring.Head()
+	list := ring.ToSinglyLinkedList()
+	if !ring.IsEmpty() {
+		t.Error("expected source ring to be empty")
+	}
+	if list.Head() != head || list.Tail().Next() != nil {
+		t.Error("expected nodes to be reused and the ring to be broken")
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", list)
+	}
+	doubly := CircularSinglyLinkedListOf(1, 2).ToDoublyLinkedList()
+	if !slices.Equal(doubly.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", doubly)
+	}
+	circular := CircularSinglyLinkedListOf(1, 2).ToCircularDoublyLinkedList()
+	if !slices.Equal(circular.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", circular)
+	}
+}
+
+func TestCircularDoublyLinkedListConversions(t *testing.T) {
+	ring := CircularDoublyLinkedListOf(1, 2, 3)
+	head := ring.Head()
+	list := ring.ToDoublyLinkedList()
+	if !ring.IsEmpty() {
+		t.Error("expected source ring to be empty")
+	}
+	if list.Head() != head || list.Head().Prev() != nil || list.Tail().Next() != nil {
+		t.Error("expected nodes to be reused and the ring to be broken")
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", list)
+	}
+	singly := CircularDoublyLinkedListOf(1, 2).ToSinglyLinkedList()
+	if !slices.Equal(singly.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", singly)
+	}
+	circular := CircularDoublyLinkedListOf(1, 2).ToCircularSinglyLinkedList()
+	if !slices.Equal(circular.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", circular)
+	}
+}
+
+func TestCopyingConversionsKeepTheReceiver(t *testing.T) {
+	singly := SinglyLinkedListOf(1, 2)
+	doubly := DoublyLinkedListOf(1, 2)
+	circularSingly := CircularSinglyLinkedListOf(1, 2)
+	circularDoubly := CircularDoublyLinkedListOf(1, 2)
+	conversions := []struct {
+		name    string
+		convert func() []int
+		source  func() []int
+	}{
+		{"SinglyLinkedList.ToDoublyLinkedList", func() []int { return singly.ToDoublyLinkedList().ToSlice() }, singly.ToSlice},
+		{"SinglyLinkedList.ToCircularDoublyLinkedList", func() []int { return singly.ToCircularDoublyLinkedList().ToSlice() }, singly.ToSlice},
+		{"DoublyLinkedList.ToSinglyLinkedList", func() []int { return doubly.ToSinglyLinkedList().ToSlice() }, doubly.ToSlice},
+		{"DoublyLinkedList.ToCircularSinglyLinkedList", func() []int { return doubly.ToCircularSinglyLinkedList().ToSlice() }, doubly.ToSlice},
+		{"CircularSinglyLinkedList.ToDoublyLinkedList", func() []int { return circularSingly.ToDoublyLinkedList().ToSlice() }, circularSingly.ToSlice},
+		{"CircularSinglyLinkedList.ToCircularDoublyLinkedList", func() []int { return circularSingly.ToCircularDoublyLinkedList().ToSlice() }, circularSingly.ToSlice},
+		{"CircularDoublyLinkedList.ToSinglyLinkedList", func() []int { return circularDoubly.ToSinglyLinkedList().ToSlice() }, circularDoubly.ToSlice},
+		{"CircularDoublyLinkedList.ToCircularSinglyLinkedList", func() []int { return circularDoubly.ToCircularSinglyLinkedList().ToSlice() }, circularDoubly.ToSlice},
+	}
+	for _, conversion := range conversions {
+		if got := conversion.convert(); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("%s: expected [1 2], got %v", conversion.name, got)
+		}
+		if got := conversion.source(); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("%s: expected the receiver to be unchanged, got %v", conversion.name, got)
+		}
+	}
+}
+
+func TestConversionsOfEmptyLists(t *testing.T) {
+	if !NewSinglyLinkedList[int]().ToCircularSinglyLinkedList().IsEmpty() {
+		t.Error("expected empty ring")
+	}
+	if !NewDoublyLinkedList[int]().ToCircularDoublyLinkedList().IsEmpty() {
+		t.Error("expected empty ring")
+	}
+	if NewCircularSinglyLinkedList[int]().ToSinglyLinkedList().Head() != nil {
+		t.Error("expected empty list")
+	}
+	if NewCircularDoublyLinkedList[int]().ToDoublyLinkedList().Tail() != nil {
+		t.Error("expected empty list")
+	}
+}
@@ -0,0 +1,590 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"iter"
+	"sync"
+)
+
+// A list with copy-on-write snapshots.
+//
+// Snapshot returns an immutable view of the current contents in O(1). The
+// snapshot shares its nodes with the list; the next write copies only the
+// nodes between the head and the position it touches, leaving the rest shared.
+// Appending never copies. Snapshots need no locking, so any number of
+// goroutines can iterate them while a single writer keeps mutating the list.
+//
+// Nodes are linked in one direction only: shared suffixes cannot carry
+// backward links, since they may belong to several versions at once.
+type COWList[T comparable] struct {
+	mu   sync.Mutex
+	head *cowNode[T]
+	tail *cowNode[T]
+	size int
+	// Generation of the nodes the list owns exclusively. Snapshot increments
+	// it, which turns every existing node into a shared one.
+	gen uint64
+}
+
+// An immutable view of a COWList taken by Snapshot.
+type COWSnapshot[T comparable] struct {
+	head *cowNode[T]
+	tail *cowNode[T]
+	size int
+}
+
+// A node of a COWList. A node whose generation is older than the list's is
+// shared with a snapshot and must be copied before its value or link changes,
+// with one exception: the next link of the tail, which no snapshot reads past
+// its own size.
+type cowNode[T comparable] struct {
+	value T
+	next  *cowNode[T]
+	gen   uint64
+}
+
+// Creates and returns a new empty copy-on-write list.
+//
+// Returns:
+//   - *COWList[T]: Pointer to a new empty list.
+//
+// Example:
+//
+//	list := list.NewCOWList[string]()
+func NewCOWList[T comparable]() *COWList[T] {
+	return &COWList[T]{}
+}
+
+// Creates and returns a new copy-on-write list holding the given values, in
+// order.
+//
+// Parameters:
+//   - values: The values to store.
+//
+// Returns:
+//   - *COWList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.COWListOf(1, 2, 3)
+func COWListOf[T comparable](values ...T) *COWList[T] {
+	l := NewCOWList[T]()
+	for _, value := range values {
+		l.appendLocked(value)
+	}
+	return l
+}
+
+// Returns an immutable view of the current contents in O(1).
+//
+// The snapshot is safe to read from any goroutine and is not affected by later
+// writes to the list.
+//
+// Returns:
+//   - *COWSnapshot[T]: The snapshot.
+//
+// Example:
+//
+//	snapshot := shared.Snapshot()
+//	go func() {
+//	    for v := range snapshot.All() {
+//	        fmt.Println(v)
+//	    }
+//	}()
+func (l *COWList[T]) Snapshot() *COWSnapshot[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.gen++
+	return l.view()
+}
+
+// Returns the number of elements in the list.
+//
+// Returns:
+//   - int: Number of elements.
+//
+// Example:
+//
+//	fmt.Println(list.Size())
+func (l *COWList[T]) Size() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.size
+}
+
+// Checks whether the list is empty.
+//
+// Returns:
+//   - bool: True if the list has no elements.
+//
+// Example:
+//
+//	if list.IsEmpty() {
+//	    return
+//	}
+func (l *COWList[T]) IsEmpty() bool {
+	return l.Size() == 0
+}
+
+// Returns the value at the specified index.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//
+// Returns:
+//   - T: The value at the index.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := list.Get(2)
+func (l *COWList[T]) Get(index int) (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.view().Get(index)
+}
+
+// Appends a value at the end of the list in O(1), without copying.
+//
+// Parameters:
+//   - value: The value to append.
+//
+// Example:
+//
+//	list.Append(4)
+func (l *COWList[T]) Append(value T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.appendLocked(value)
+}
+
+// Inserts a value at the beginning of the list in O(1), without copying.
+//
+// Parameters:
+//   - value: The value to insert.
+//
+// Example:
+//
+//	list.Prepend(0)
+func (l *COWList[T]) Prepend(value T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.head = &cowNode[T]{value: value, next: l.head, gen: l.gen}
+	if l.tail == nil {
+		l.tail = l.head
+	}
+	l.size++
+}
+
+// Inserts a value at the specified index, copying the shared nodes before it.
+//
+// Parameters:
+//   - index: Position at which to insert (0-based).
+//   - value: The value to insert.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := list.InsertAt(1, 5)
+func (l *COWList[T]) InsertAt(index int, value T) error {
+	if index == 0 {
+		l.Prepend(value)
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if index < 0 || index > l.size {
+		return newIndexError("InsertAt", index, l.size)
+	}
+	if index == l.size {
+		l.appendLocked(value)
+		return nil
+	}
+	prev := l.ownedAt(index - 1)
+	prev.next = &cowNode[T]{value: value, next: prev.next, gen: l.gen}
+	l.size++
+	return nil
+}
+
+// Updates the value at the specified index, copying the shared nodes up to
+// and including it.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//   - value: The new value.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := list.Set(0, 42)
+func (l *COWList[T]) Set(index int, value T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if index < 0 || index >= l.size {
+		return newIndexError("Set", index, l.size)
+	}
+	l.ownedAt(index).value = value
+	return nil
+}
+
+// Removes the element at the specified index, copying the shared nodes
+// before it.
+//
+// Parameters:
+//   - index: Position of the element to remove (0-based).
+//
+// Returns:
+//   - T: The removed value.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := list.RemoveAt(1)
+func (l *COWList[T]) RemoveAt(index int) (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, newIndexError("RemoveAt", index, l.size)
+	}
+	var prev, removed *cowNode[T]
+	if index == 0 {
+		removed = l.head
+		l.head = removed.next
+	} else {
+		prev = l.ownedAt(index - 1)
+		removed = prev.next
+		prev.next = removed.next
+	}
+	if removed == l.tail {
+		l.tail = prev
+	}
+	l.size--
+	return removed.value, nil
+}
+
+// Removes the first element in O(1), without copying.
+//
+// Returns:
+//   - bool: True if an element was removed.
+//
+// Example:
+//
+//	list.RemoveFirst()
+func (l *COWList[T]) RemoveFirst() bool {
+	_, err := l.RemoveAt(0)
+	return err == nil
+}
+
+// Removes every element in O(1). Existing snapshots are unaffected.
+//
+// Example:
+//
+//	list.Clear()
+func (l *COWList[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// Returns an iterator over a snapshot of the list taken when iteration
+// starts, so the loop body may modify the list.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range list.All() {
+//	    fmt.Println(v)
+//	}
+func (l *COWList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		l.Snapshot().All()(yield)
+	}
+}
+
+// Returns a slice containing the elements of the list.
+//
+// Returns:
+//   - []T: Slice of the elements, from head to tail.
+//
+// Example:
+//
+//	values := list.ToSlice()
+func (l *COWList[T]) ToSlice() []T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.view().ToSlice()
+}
+
+// Returns a human-readable representation of the list.
+//
+// Returns:
+//   - string: The formatted list.
+//
+// Example:
+//
+//	fmt.Println(list) // COWList: [1] -> [2] -> [3]
+func (l *COWList[T]) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	layout := l.view().layout()
+	layout.name = "COWList"
+	return listString(layout)
+}
+
+// Returns a view of the current contents without marking the nodes as shared,
+// so it is only valid while the caller holds the lock.
+func (l *COWList[T]) view() *COWSnapshot[T] {
+	return &COWSnapshot[T]{head: l.head, tail: l.tail, size: l.size}
+}
+
+// Appends a value; the caller holds the lock. Linking after a shared tail is
+// safe because snapshots holding it stop there.
+func (l *COWList[T]) appendLocked(value T) {
+	node := &cowNode[T]{value: value, gen: l.gen}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.size++
+}
+
+// Returns the node at index, first replacing every shared node from the head
+// up to it with an owned copy. The index must be within bounds.
+func (l *COWList[T]) ownedAt(index int) *cowNode[T] {
+	var prev *cowNode[T]
+	current := l.head
+	for i := 0; ; i++ {
+		if current.gen != l.gen {
+			copied := &cowNode[T]{value: current.value, next: current.next, gen: l.gen}
+			if prev == nil {
+				l.head = copied
+			} else {
+				prev.next = copied
+			}
+			if current == l.tail {
+				l.tail = copied
+			}
+			current = copied
+		}
+		if i == index {
+			return current
+		}
+		prev, current = current, current.next
+	}
+}
+
+// Returns the number of elements in the snapshot.
+//
+// Returns:
+//   - int: Number of elements.
+//
+// Example:
+//
+//	fmt.Println(snapshot.Size())
+func (s *COWSnapshot[T]) Size() int {
+	return s.size
+}
+
+// Checks whether the snapshot is empty.
+//
+// Returns:
+//   - bool: True if the snapshot has no elements.
+//
+// Example:
+//
+//	if snapshot.IsEmpty() {
+//	    return
+//	}
+func (s *COWSnapshot[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Returns the value at the specified index.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//
+// Returns:
+//   - T: The value at the index.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := snapshot.Get(0)
+func (s *COWSnapshot[T]) Get(index int) (T, error) {
+	if index < 0 || index >= s.size {
+		var zero T
+		return zero, newIndexError("Get", index, s.size)
+	}
+	current := s.head
+	for range index {
+		current = current.next
+	}
+	return current.value, nil
+}
+
+// Returns an iterator over the values of the snapshot, from head to tail.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range snapshot.All() {
+//	    fmt.Println(v)
+//	}
+func (s *COWSnapshot[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := s.head
+		for i := range s.size {
+			// Never read the link of the last node: the writer may be
+			// appending after it.
+			if i > 0 {
+				current = current.next
+			}
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a slice containing the elements of the snapshot.
+//
+// Returns:
+//   - []T: Slice of the elements, from head to tail.
+//
+// Example:
+//
+//	values := snapshot.ToSlice()
+func (s *COWSnapshot[T]) ToSlice() []T {
+	result := make([]T, 0, s.size)
+	for value := range s.All() {
+		result = append(result, value)
+	}
+	return result
+}
+
+// Returns a human-readable representation of the snapshot.
+//
+// Returns:
+//   - string: The formatted snapshot.
+//
+// Example:
+//
+//	fmt.Println(snapshot) // COWSnapshot: [1] -> [2] -> [3]
+func (s *COWSnapshot[T]) String() string {
+	return listString(s.layout())
+}
+
+// Describes the snapshot for the shared formatting helpers.
+func (s *COWSnapshot[T]) layout() listLayout[T] {
+	layout := listLayout[T]{
+		name:      "COWSnapshot",
+		separator: " -> ",
+		size:      s.size,
+		values:    s.All(),
+	}
+	if s.size > 0 {
+		layout.head = s.head.value
+		layout.tail = s.tail.value
+	}
+	return layout
+}
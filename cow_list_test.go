@@ -0,0 +1,110 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestCOWListSnapshotIsolation(t *testing.T) {
+	list := COWListOf(1, 2, 3, 4)
+	snapshot := list.Snapshot()
+	list.Set(1, 20)
+	list.InsertAt(2, 25)
+	list.RemoveAt(0)
+	list.Append(5)
+	list.Prepend(0)
+	if !slices.Equal(snapshot.ToSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("expected the snapshot to stay [1 2 3 4], got %v", snapshot.ToSlice())
+	}
+	if !slices.Equal(list.ToSlice(), []int{0, 20, 25, 3, 4, 5}) {
+		t.Errorf("expected [0 20 25 3 4 5], got %v", list.ToSlice())
+	}
+
+	second := list.Snapshot()
+	list.RemoveAt(5)
+	list.RemoveAt(3)
+	list.Append(6)
+	list.Clear()
+	if !slices.Equal(second.ToSlice(), []int{0, 20, 25, 3, 4, 5}) {
+		t.Errorf("expected the second snapshot to be unchanged, got %v", second.ToSlice())
+	}
+	if !slices.Equal(snapshot.ToSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("expected the first snapshot to be unchanged, got %v", snapshot.ToSlice())
+	}
+	if !list.IsEmpty() || second.Size() != 6 || second.String() != "COWSnapshot: [0] -> [20] -> [25] -> [3] -> [4] -> [5]" {
+		t.Errorf("unexpected state %v / %v", list, second)
+	}
+}
+
+func TestCOWListCopiesOnlyTouchedSegment(t *testing.T) {
+	list := COWListOf(1, 2, 3, 4, 5)
+	snapshot := list.Snapshot()
+	list.Set(1, 20)
+	if list.head == snapshot.head || list.head.next == snapshot.head.next {
+		t.Error("expected the nodes up to the write to be copied")
+	}
+	if list.head.next.next != snapshot.head.next.next {
+		t.Error("expected the nodes after the write to stay shared")
+	}
+
+	copied := list.head
+	list.Set(0, 10)
+	if list.head != copied {
+		t.Error("expected an owned node to be updated in place")
+	}
+
+	tail := list.tail
+	list.Append(6)
+	if tail != snapshot.tail || tail.next != list.tail {
+		t.Error("expected Append to link after the shared tail without copying")
+	}
+	if !slices.Equal(snapshot.ToSlice(), []int{1, 2, 3, 4, 5}) {
+		t.Errorf("expected the snapshot to stop at its own size, got %v", snapshot.ToSlice())
+	}
+}
+
+func TestCOWListIndexErrors(t *testing.T) {
+	list := COWListOf(1)
+	if err := list.Set(1, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+	if err := list.InsertAt(3, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+	if _, err := list.Snapshot().Get(-1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+	if !list.RemoveFirst() || list.RemoveFirst() {
+		t.Error("expected exactly one RemoveFirst to succeed")
+	}
+	if _, err := list.RemoveAt(0); !errors.Is(err, ErrEmptyList) {
+		t.Errorf("expected ErrEmptyList, got %v", err)
+	}
+}
+
+func TestCOWListConcurrentReaders(t *testing.T) {
+	list := COWListOf(0, 1, 2, 3)
+	var wg sync.WaitGroup
+	for range 4 {
+		snapshot := list.Snapshot()
+		expected := snapshot.ToSlice()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if got := slices.Collect(snapshot.All()); !slices.Equal(got, expected) {
+					t.Errorf("expected %v, got %v", expected, got)
+					return
+				}
+			}
+		}()
+		for i := range 50 {
+			list.Append(i)
+			list.Set(i%list.Size(), -i)
+			list.RemoveAt(list.Size() / 2)
+		}
+	}
+	wg.Wait()
+}
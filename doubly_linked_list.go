@@ -89,7 +89,12 @@
 // Random access operations (Get, Set) have O(n) complexity due to linear traversal.
 package list
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+)
 
 // Represents a generic doubly linked list.
 //
@@ -98,6 +103,11 @@ type DoublyLinkedList[T comparable] struct {
 	head *DoublyLinkedNode[T]
 	tail *DoublyLinkedNode[T]
 	size int
+	// Incremented on every structural change so that views can detect that
+	// they have been invalidated.
+	version int
+	// Notified of mutations when set; see SetObserver.
+	observer Observer[T]
 }
 
 // Creates and returns a new empty doubly linked list.
@@ -112,6 +122,42 @@ func NewDoublyLinkedList[T comparable]() *DoublyLinkedList[T] {
 	return &DoublyLinkedList[T]{}
 }
 
+// Creates and returns a new doubly linked list holding the given
+// values, in order.
+//
+// Parameters:
+//   - values: The values to store.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.DoublyLinkedListOf(1, 2, 3)
+func DoublyLinkedListOf[T comparable](values ...T) *DoublyLinkedList[T] {
+	return DoublyLinkedListFromSlice(values)
+}
+
+// Creates and returns a new doubly linked list holding the elements
+// of a slice.
+//
+// The slice is not retained.
+//
+// Parameters:
+//   - values: The slice to copy.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.DoublyLinkedListFromSlice([]int{1, 2, 3})
+func DoublyLinkedListFromSlice[T comparable](values []T) *DoublyLinkedList[T] {
+	l := NewDoublyLinkedList[T]()
+	l.AppendAll(values...)
+	return l
+}
+
 // Returns the first node of the list.
 //
 // Returns:
@@ -167,9 +213,14 @@ func (l *DoublyLinkedList[T]) IsEmpty() bool {
 //	list.Clear()
 //	fmt.Println(list.IsEmpty()) // true
 func (l *DoublyLinkedList[T]) Clear() {
+	head, size := l.head, l.size
 	l.head = nil
 	l.tail = nil
 	l.size = 0
+	l.version++
+	if l.observer != nil {
+		notifyDoublyRemoved(l.observer, 0, head, size)
+	}
 }
 
 // Inserts a new element at the beginning of the list.
@@ -190,6 +241,10 @@ func (l *DoublyLinkedList[T]) Prepend(value T) {
 	}
 	l.head = newNode
 	l.size++
+	l.version++
+	if l.observer != nil {
+		l.observer.OnInsert(0, value)
+	}
 }
 
 // Inserts a new element at the end of the list.
@@ -210,6 +265,108 @@ func (l *DoublyLinkedList[T]) Append(value T) {
 	}
 	l.tail = newNode
 	l.size++
+	l.version++
+	if l.observer != nil {
+		l.observer.OnInsert(l.size-1, value)
+	}
+}
+
+// Inserts the given values at the beginning of the list, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.PrependAll(1, 2, 3)
+func (l *DoublyLinkedList[T]) PrependAll(values ...T) {
+	first, last := newDoublyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(nil, first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, 0, values)
+	}
+}
+
+// Inserts the given values at the end of the list, preserving their order,
+// linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.AppendAll(4, 5, 6)
+func (l *DoublyLinkedList[T]) AppendAll(values ...T) {
+	first, last := newDoublyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(l.Tail(), first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, l.size-len(values), values)
+	}
+}
+
+// Inserts the given values starting at the specified index, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - index: Position at which to insert the first value (0-based).
+//   - values: The values to insert.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := list.InsertAllAt(1, 7, 8, 9)
+func (l *DoublyLinkedList[T]) InsertAllAt(index int, values ...T) error {
+	if index < 0 || index > l.Size() {
+		return newIndexError("InsertAllAt", index, l.Size())
+	}
+	if index == l.Size() {
+		l.AppendAll(values...)
+		return nil
+	}
+	first, last := newDoublyChain(slices.Values(values))
+	if first == nil {
+		return nil
+	}
+	var prev *DoublyLinkedNode[T]
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	l.linkAfter(prev, first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, index, values)
+	}
+	return nil
+}
+
+// Splices the chain from first to last after prev, or at the front of the
+// list when prev is nil.
+func (l *DoublyLinkedList[T]) linkAfter(prev, first, last *DoublyLinkedNode[T], count int) {
+	var next *DoublyLinkedNode[T]
+	if prev == nil {
+		next = l.head
+		l.head = first
+	} else {
+		next = prev.Next()
+		prev.SetNext(first)
+	}
+	first.SetPrev(prev)
+	last.SetNext(next)
+	if next == nil {
+		l.tail = last
+	} else {
+		next.SetPrev(last)
+	}
+	l.size += count
+	l.version++
 }
 
 // Searches for the first node containing the specified value.
@@ -232,46 +389,206 @@ func (l *DoublyLinkedList[T]) Find(value T) *DoublyLinkedNode[T] {
 	return nil
 }
 
+// Returns the index of the first occurrence of the specified value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.IndexOf(7)
+func (l *DoublyLinkedList[T]) IndexOf(value T) int {
+	index := 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		if current.Value() == value {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Returns the index of the last occurrence of the specified value, walking backward from the tail.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.LastIndexOf(7)
+func (l *DoublyLinkedList[T]) LastIndexOf(value T) int {
+	index := l.Size() - 1
+	for current := l.Tail(); current != nil; current = current.Prev() {
+		if current.Value() == value {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+// Searches for the first node whose value satisfies the predicate.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *DoublyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindFunc(func(v int) bool { return v > 10 })
+func (l *DoublyLinkedList[T]) FindFunc(predicate func(T) bool) *DoublyLinkedNode[T] {
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Value()) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Searches for the last node whose value satisfies the predicate, walking backward from the tail.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *DoublyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindLast(func(v int) bool { return v > 10 })
+func (l *DoublyLinkedList[T]) FindLast(predicate func(T) bool) *DoublyLinkedNode[T] {
+	for current := l.Tail(); current != nil; current = current.Prev() {
+		if predicate(current.Value()) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Collects every node whose value satisfies the predicate, in list order.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - []*DoublyLinkedNode[T]: The matching nodes, or nil if there are none.
+//
+// Example:
+//
+//	nodes := list.FindAll(func(v int) bool { return v%2 == 0 })
+func (l *DoublyLinkedList[T]) FindAll(predicate func(T) bool) []*DoublyLinkedNode[T] {
+	var nodes []*DoublyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Value()) {
+			nodes = append(nodes, current)
+		}
+	}
+	return nodes
+}
+
+// Counts the occurrences of the specified value.
+//
+// Parameters:
+//   - value: The value to count.
+//
+// Returns:
+//   - int: Number of elements equal to value.
+//
+// Example:
+//
+//	n := list.Count(7)
+func (l *DoublyLinkedList[T]) Count(value T) int {
+	count := 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		if current.Value() == value {
+			count++
+		}
+	}
+	return count
+}
+
+// Reports whether the list contains the specified value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: true if found, false otherwise.
+//
+// Example:
+//
+//	fmt.Println(list.Contains(5)) // true
+func (l *DoublyLinkedList[T]) Contains(value T) bool {
+	return l.Find(value) != nil
+}
+
 // Removes the first element from the list.
 //
 // If the list is empty, the operation has no effect.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveFirst()
-func (l *DoublyLinkedList[T]) RemoveFirst() {
+func (l *DoublyLinkedList[T]) RemoveFirst() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
-	l.head = l.Head().Next()
+	node := l.Head()
+	l.head = node.Next()
 	l.size--
+	l.version++
 	if l.IsEmpty() {
 		l.tail = nil
 	} else {
 		l.head.SetPrev(nil)
 	}
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	return true
 }
 
 // Removes the last element from the list.
 //
-// If the list is empty, the operation has no effect,
+// If the list is empty, the operation has no effect.
+//
+// Returns:
+//   - bool: true if an element was removed, false if the list was empty.
 //
 // Example:
 //
 //	list.RemoveLast()
-func (l *DoublyLinkedList[T]) RemoveLast() {
+func (l *DoublyLinkedList[T]) RemoveLast() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
+	node := l.Tail()
 	if l.Size() == 1 {
 		l.head = nil
 		l.tail = nil
 		l.size = 0
-		return
+		l.version++
+	} else {
+		l.tail = node.Prev()
+		l.tail.SetNext(nil)
+		l.size--
+		l.version++
 	}
-	l.tail = l.Tail().Prev()
-	l.tail.SetNext(nil)
-	l.size--
+	if l.observer != nil {
+		l.observer.OnRemove(l.size, node.Value())
+	}
+	return true
 }
 
 // Deletes the first occurrence of the specified value from the list.
@@ -279,25 +596,322 @@ func (l *DoublyLinkedList[T]) RemoveLast() {
 // Parameters:
 //   - value: The value to remove.
 //
+// Returns:
+//   - bool: true if an element was removed, false if the value was not found.
+//
 // Example:
 //
 //	list.Remove(10)
-func (l *DoublyLinkedList[T]) Remove(value T) {
+func (l *DoublyLinkedList[T]) Remove(value T) bool {
+	if l.observer != nil {
+		return l.removeObserved(value)
+	}
 	node := l.Find(value)
 	if node == nil {
-		return
+		return false
 	}
 	if node == l.Head() {
-		l.RemoveFirst()
-		return
+		return l.RemoveFirst()
 	}
 	if node == l.Tail() {
-		l.RemoveLast()
-		return
+		return l.RemoveLast()
 	}
 	node.Prev().SetNext(node.Next())
 	node.Next().SetPrev(node.Prev())
 	l.size--
+	l.version++
+	return true
+}
+
+// Removes and returns the element at the specified index.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//
+// Returns:
+//   - T: The removed value, or the zero value on error.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := list.RemoveAt(1)
+func (l *DoublyLinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.Size() {
+		var zero T
+		return zero, newIndexError("RemoveAt", index, l.Size())
+	}
+	node := l.nodeAt(index)
+	l.unlink(node)
+	if l.observer != nil {
+		l.observer.OnRemove(index, node.Value())
+	}
+	return node.Value(), nil
+}
+
+// Deletes every occurrence of the specified value from the list.
+//
+// Parameters:
+//   - value: The value to remove.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveAll(10)
+func (l *DoublyLinkedList[T]) RemoveAll(value T) int {
+	return l.RemoveIf(func(v T) bool { return v == value })
+}
+
+// Deletes every element for which the predicate returns true, in a single
+// pass.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be removed.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveIf(func(v int) bool { return v%2 == 0 })
+func (l *DoublyLinkedList[T]) RemoveIf(predicate func(T) bool) int {
+	removed, index := 0, 0
+	for current := l.Head(); current != nil; {
+		next := current.Next()
+		if predicate(current.Value()) {
+			l.unlink(current)
+			removed++
+			if l.observer != nil {
+				l.observer.OnRemove(index, current.Value())
+			}
+		} else {
+			index++
+		}
+		current = next
+	}
+	return removed
+}
+
+// Keeps only the elements for which the predicate returns true.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be kept.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Retain(func(v int) bool { return v > 0 })
+func (l *DoublyLinkedList[T]) Retain(predicate func(T) bool) int {
+	return l.RemoveIf(func(v T) bool { return !predicate(v) })
+}
+
+// Removes every element equal to an earlier one, keeping the first
+// occurrence, in a single pass that tracks seen values in a set.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Unique()
+func (l *DoublyLinkedList[T]) Unique() int {
+	seen := make(map[T]struct{}, l.Size())
+	return l.RemoveIf(func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+}
+
+// Removes every element that the equality function reports as equal to an
+// earlier one, keeping the first occurrence.
+//
+// Since no set can be built from an arbitrary equality, this runs in O(n*k)
+// where k is the number of distinct elements.
+//
+// Parameters:
+//   - equal: Function reporting whether two values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.UniqueFunc(strings.EqualFold)
+func (l *DoublyLinkedList[T]) UniqueFunc(equal func(a, b T) bool) int {
+	var kept []T
+	return l.RemoveIf(func(v T) bool {
+		for _, k := range kept {
+			if equal(k, v) {
+				return true
+			}
+		}
+		kept = append(kept, v)
+		return false
+	})
+}
+
+// Collapses runs of adjacent equal elements into a single element, like
+// slices.Compact.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Compact()
+func (l *DoublyLinkedList[T]) Compact() int {
+	return l.CompactFunc(func(a, b T) bool { return a == b })
+}
+
+// Collapses runs of adjacent elements that the equality function reports as
+// equal, keeping the first element of each run, like slices.CompactFunc.
+//
+// Parameters:
+//   - equal: Function reporting whether two adjacent values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.CompactFunc(strings.EqualFold)
+func (l *DoublyLinkedList[T]) CompactFunc(equal func(a, b T) bool) int {
+	var last T
+	started := false
+	return l.RemoveIf(func(v T) bool {
+		if started && equal(last, v) {
+			return true
+		}
+		last, started = v, true
+		return false
+	})
+}
+
+// Splits the list into the elements that satisfy the predicate and those that
+// do not, preserving their relative order.
+//
+// The nodes are relinked into the two results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element belongs to the first list.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: The elements for which predicate returned true.
+//   - *DoublyLinkedList[T]: The remaining elements.
+//
+// Example:
+//
+//	evens, odds := list.Partition(func(v int) bool { return v%2 == 0 })
+func (l *DoublyLinkedList[T]) Partition(predicate func(T) bool) (*DoublyLinkedList[T], *DoublyLinkedList[T]) {
+	matched, rest := NewDoublyLinkedList[T](), NewDoublyLinkedList[T]()
+	for !l.IsEmpty() {
+		if predicate(l.first()) {
+			l.moveFirstTo(matched)
+		} else {
+			l.moveFirstTo(rest)
+		}
+	}
+	return matched, rest
+}
+
+// Splits the list into the elements less than, equal to and greater than the
+// pivot, preserving their relative order within each part.
+//
+// The nodes are relinked into the three results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - pivot: The value to partition around.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: The elements less than pivot.
+//   - *DoublyLinkedList[T]: The elements equal to pivot.
+//   - *DoublyLinkedList[T]: The elements greater than pivot.
+//
+// Example:
+//
+//	less, equal, greater := list.PartitionAround(5, cmp.Compare[int])
+func (l *DoublyLinkedList[T]) PartitionAround(pivot T, cmp func(x, y T) int) (*DoublyLinkedList[T], *DoublyLinkedList[T], *DoublyLinkedList[T]) {
+	less, equal, greater := NewDoublyLinkedList[T](), NewDoublyLinkedList[T](), NewDoublyLinkedList[T]()
+	for !l.IsEmpty() {
+		switch c := cmp(l.first(), pivot); {
+		case c < 0:
+			l.moveFirstTo(less)
+		case c > 0:
+			l.moveFirstTo(greater)
+		default:
+			l.moveFirstTo(equal)
+		}
+	}
+	return less, equal, greater
+}
+
+// Deletes the elements in the half-open range [from, to).
+//
+// Parameters:
+//   - from: Index of the first element to remove.
+//   - to: Index one past the last element to remove.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.RemoveRange(1, 3)
+func (l *DoublyLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange("RemoveRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	first := l.nodeAt(from)
+	last := first
+	for range to - from - 1 {
+		last = last.Next()
+	}
+	before, after := first.Prev(), last.Next()
+	if before == nil {
+		l.head = after
+	} else {
+		before.SetNext(after)
+	}
+	if after == nil {
+		l.tail = before
+	} else {
+		after.SetPrev(before)
+	}
+	l.size -= to - from
+	l.version++
+	if l.observer != nil {
+		notifyDoublyRemoved(l.observer, from, first, to-from)
+	}
+	return nil
+}
+
+// Detaches the given node, which must belong to the list, from its
+// neighbours.
+func (l *DoublyLinkedList[T]) unlink(node *DoublyLinkedNode[T]) {
+	if node.Prev() == nil {
+		l.head = node.Next()
+	} else {
+		node.Prev().SetNext(node.Next())
+	}
+	if node.Next() == nil {
+		l.tail = node.Prev()
+	} else {
+		node.Next().SetPrev(node.Prev())
+	}
+	l.size--
+	l.version++
 }
 
 // Returns a string representation of the list.
@@ -309,20 +923,70 @@ func (l *DoublyLinkedList[T]) Remove(value T) {
 //
 //	fmt.Println(list.String()) // DoublyLinkedList: [1] ↔ [2] ↔ [3]
 func (l *DoublyLinkedList[T]) String() string {
-	if l.IsEmpty() {
-		return "DoublyLinkedList: []"
+	return listString(l.layout())
+}
+
+// Implements fmt.Formatter.
+//
+// The verbs %v and %s print the compact form returned by String, %+v also
+// reports the size and the head and tail values, and %#v prints Go syntax that
+// rebuilds the list, such as list.DoublyLinkedListOf[int](1, 2). Any other verb
+// is applied to each element, along with the # flag. A precision (or, failing
+// that, a width) limits how many elements are printed, replacing the rest with
+// an ellipsis.
+//
+// Parameters:
+//   - f: The formatter state.
+//   - verb: The formatting verb.
+//
+// Example:
+//
+//	fmt.Printf("%.2v\n", list) // DoublyLinkedList: [0] ↔ [1] ↔ ...
+func (l *DoublyLinkedList[T]) Format(f fmt.State, verb rune) {
+	formatList(f, verb, l.layout())
+}
+
+// Streams the compact representation of the list to w without building the
+// whole string in memory.
+//
+// Parameters:
+//   - w: The destination writer.
+//
+// Returns:
+//   - int64: Number of bytes written.
+//   - error: The first error returned by w, if any.
+//
+// Example:
+//
+//	_, err := list.WriteTo(os.Stdout)
+func (l *DoublyLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeList(w, l.layout(), defaultFormatOptions)
+}
+
+// Describes the list for the shared formatting helpers.
+func (l *DoublyLinkedList[T]) layout() listLayout[T] {
+	layout := listLayout[T]{
+		name:      "DoublyLinkedList",
+		separator: " ↔ ",
+		size:      l.Size(),
+		values:    l.values(),
 	}
-	result := "DoublyLinkedList: "
-	current := l.Head()
-	for {
-		result += fmt.Sprintf("[%v]", current.Value())
-		if !current.HasNext() {
-			break
+	if !l.IsEmpty() {
+		layout.head = l.Head().Value()
+		layout.tail = l.Tail().Value()
+	}
+	return layout
+}
+
+// Returns an iterator over the values of the list, from head to tail.
+func (l *DoublyLinkedList[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.Head(); current != nil; current = current.Next() {
+			if !yield(current.Value()) {
+				return
+			}
 		}
-		result += " ↔ "
-		current = current.Next()
 	}
-	return result
 }
 
 // Inserts a new element at the specified index.
@@ -332,14 +996,14 @@ func (l *DoublyLinkedList[T]) String() string {
 //   - value: The value to insert.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	err := list.InsertAt(2, 99)
 func (l *DoublyLinkedList[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > l.Size() {
-		return fmt.Errorf("index %d out of bounds", index)
+		return newIndexError("InsertAt", index, l.Size())
 	}
 	if index == 0 {
 		l.Prepend(value)
@@ -360,6 +1024,10 @@ func (l *DoublyLinkedList[T]) InsertAt(index int, value T) error {
 	newNode.SetNext(current)
 	current.SetPrev(newNode)
 	l.size++
+	l.version++
+	if l.observer != nil {
+		l.observer.OnInsert(index, value)
+	}
 	return nil
 }
 
@@ -370,20 +1038,35 @@ func (l *DoublyLinkedList[T]) InsertAt(index int, value T) error {
 //
 // Returns:
 //   - *DoublyLinkedNode[T]: Pointer to the node.
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	node, err := list.Get(1)
 func (l *DoublyLinkedList[T]) Get(index int) (*DoublyLinkedNode[T], error) {
 	if index < 0 || index >= l.Size() {
-		return nil, fmt.Errorf("index %d out of bounds", index)
+		return nil, newIndexError("Get", index, l.Size())
 	}
-	current := l.Head()
-	for range index {
-		current = current.Next()
+	return l.nodeAt(index), nil
+}
+
+// Returns the node at the given index, walking from whichever end of the list
+// is closer.
+//
+// The index must be within bounds.
+func (l *DoublyLinkedList[T]) nodeAt(index int) *DoublyLinkedNode[T] {
+	if index < l.Size()/2 {
+		current := l.Head()
+		for range index {
+			current = current.Next()
+		}
+		return current
+	}
+	current := l.Tail()
+	for range l.Size() - 1 - index {
+		current = current.Prev()
 	}
-	return current, nil
+	return current
 }
 
 // Updates the value of the node at the specified index.
@@ -393,17 +1076,21 @@ func (l *DoublyLinkedList[T]) Get(index int) (*DoublyLinkedNode[T], error) {
 //   - value: New value to set.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	err := list.Set(0, 42)
 func (l *DoublyLinkedList[T]) Set(index int, value T) error {
-	node, err := l.Get(index)
-	if err != nil {
-		return err
+	if index < 0 || index >= l.Size() {
+		return newIndexError("Set", index, l.Size())
 	}
+	node := l.nodeAt(index)
+	old := node.Value()
 	node.SetValue(value)
+	if l.observer != nil {
+		l.observer.OnSet(index, old, value)
+	}
 	return nil
 }
 
@@ -424,6 +1111,89 @@ func (l *DoublyLinkedList[T]) Reverse() {
 		current = next
 	}
 	l.head = prev
+	l.version++
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the order of the elements in the half-open range [from, to) by
+// relinking their nodes in place.
+//
+// Parameters:
+//   - from: Index of the first element to reverse.
+//   - to: Index one past the last element to reverse.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.ReverseRange(1, 4)
+func (l *DoublyLinkedList[T]) ReverseRange(from, to int) error {
+	if err := checkRange("ReverseRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if to-from < 2 {
+		return nil
+	}
+	l.reverseSegment(l.nodeAt(from), to-from)
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+	return nil
+}
+
+// Reverses every consecutive group of k elements in place. A trailing group
+// with fewer than k elements is reversed as well.
+//
+// Parameters:
+//   - k: The size of each group. ReverseGroups panics if k is less than 1.
+//
+// Example:
+//
+//	list.ReverseGroups(2) // [1 2 3 4 5] becomes [2 1 4 3 5]
+func (l *DoublyLinkedList[T]) ReverseGroups(k int) {
+	if k < 1 {
+		panic("list: group size cannot be less than 1")
+	}
+	first := l.Head()
+	for remaining := l.Size(); remaining > 0; remaining -= k {
+		first = l.reverseSegment(first, min(k, remaining)).Next()
+	}
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the count nodes starting at first and returns first, which now
+// ends the reversed segment.
+//
+// count must be at least 1 and no greater than the number of nodes available.
+func (l *DoublyLinkedList[T]) reverseSegment(first *DoublyLinkedNode[T], count int) *DoublyLinkedNode[T] {
+	l.version++
+	before := first.Prev()
+	var reversed *DoublyLinkedNode[T]
+	current := first
+	for range count {
+		next := current.Next()
+		current.next, current.prev = current.Prev(), next
+		reversed = current
+		current = next
+	}
+	first.SetNext(current)
+	reversed.SetPrev(before)
+	if before == nil {
+		l.head = reversed
+	} else {
+		before.SetNext(reversed)
+	}
+	if current == nil {
+		l.tail = first
+	} else {
+		current.SetPrev(first)
+	}
+	return first
 }
 
 // Applies a provided function to each element in the list.
@@ -440,6 +1210,20 @@ func (l *DoublyLinkedList[T]) ForEach(action func(T)) {
 	}
 }
 
+// Returns an iterator over the elements of the list, from head to tail.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range list.All() {
+//	    fmt.Println(v)
+//	}
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return l.values()
+}
+
 // Returns a slice containing all elements of the list.
 //
 // Returns:
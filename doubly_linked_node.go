@@ -89,6 +89,8 @@
 // Random access operations (Get, Set) have O(n) complexity due to linear traversal.
 package list
 
+import "iter"
+
 // Represents a node in a doubly linked list.
 //
 // Each node holds a value of type T and pointers to the next and previous nodes in
@@ -213,3 +215,26 @@ func (n *DoublyLinkedNode[T]) Prev() *DoublyLinkedNode[T] {
 func (n *DoublyLinkedNode[T]) HasPrev() bool {
 	return n.prev != nil
 }
+
+// Links the given values into a new chain of doubly linked nodes.
+//
+// Parameters:
+//   - values: The values to store, in order.
+//
+// Returns:
+//   - *DoublyLinkedNode[T]: The first node of the chain, or nil if values is empty.
+//   - *DoublyLinkedNode[T]: The last node of the chain, or nil if values is empty.
+func newDoublyChain[T comparable](values iter.Seq[T]) (*DoublyLinkedNode[T], *DoublyLinkedNode[T]) {
+	var first, last *DoublyLinkedNode[T]
+	for value := range values {
+		node := NewDoublyLinkedNode(value)
+		if first == nil {
+			first = node
+		} else {
+			last.next = node
+			node.prev = last
+		}
+		last = node
+	}
+	return first, last
+}
@@ -0,0 +1,280 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+// Repeatedly removes every k-th element around the ring until one is left, as
+// in the Josephus problem.
+//
+// Counting begins at index start, which counts as the first element, and
+// resumes after each removal at the element that followed the removed one.
+// Nodes are unlinked in place, so the list ends up holding only the survivor.
+// It runs in O(n·k) time.
+//
+// Parameters:
+//   - k: The step between removals. EliminateEvery panics if k is less than 1.
+//   - start: Index of the element where counting begins.
+//
+// Returns:
+//   - []T: The removed values, in order of removal.
+//   - T: The value of the survivor, or the zero value on error.
+//   - error: An *IndexError if start is out of bounds, which also matches
+//     ErrEmptyList when the list is empty.
+//
+// Example:
+//
+//	list := list.CircularSinglyLinkedListOf(1, 2, 3, 4, 5, 6, 7)
+//	order, survivor, _ := list.EliminateEvery(3, 0)
+//	fmt.Println(order, survivor) // [3 6 2 7 5 1] 4
+func (l *CircularSinglyLinkedList[T]) EliminateEvery(k, start int) ([]T, T, error) {
+	if k < 1 {
+		panic("list: step cannot be less than 1")
+	}
+	var survivor T
+	if start < 0 || start >= l.Size() {
+		return nil, survivor, newIndexError("EliminateEvery", start, l.Size())
+	}
+	order := make([]T, 0, l.Size()-1)
+	prev := l.Tail()
+	if start > 0 {
+		prev = l.nodeAt(start - 1)
+	}
+	index := start
+	for l.Size() > 1 {
+		for range (k - 1) % l.Size() {
+			prev = prev.Next()
+			index = (index + 1) % l.Size()
+		}
+		node := l.unlinkAfter(prev)
+		order = append(order, node.Value())
+		if l.observer != nil {
+			l.observer.OnRemove(index, node.Value())
+		}
+		if index == l.Size() {
+			index = 0
+		}
+	}
+	return order, l.Head().Value(), nil
+}
+
+// Walks forward from index start, removing elements for as long as the
+// predicate returns true.
+//
+// The walk continues past the tail to the head, and stops at the first element
+// for which the predicate returns false or once the list is empty, so every
+// element is visited at most once.
+//
+// Parameters:
+//   - start: Index of the first element to test.
+//   - predicate: Function reporting whether an element should be removed.
+//
+// Returns:
+//   - int: Number of elements removed.
+//   - error: An *IndexError if start is out of bounds.
+//
+// Example:
+//
+//	list := list.CircularSinglyLinkedListOf(1, 2, 3, 4, 5, 6)
+//	list.RemoveWhile(4, func(v int) bool { return v != 3 })
+//	fmt.Println(list.ToSlice()) // [3 4]
+func (l *CircularSinglyLinkedList[T]) RemoveWhile(start int, predicate func(T) bool) (int, error) {
+	if start < 0 || start >= l.Size() {
+		return 0, newIndexError("RemoveWhile", start, l.Size())
+	}
+	prev := l.Tail()
+	if start > 0 {
+		prev = l.nodeAt(start - 1)
+	}
+	removed, index := 0, start
+	for !l.IsEmpty() && predicate(prev.Next().Value()) {
+		node := l.unlinkAfter(prev)
+		removed++
+		if l.observer != nil {
+			l.observer.OnRemove(index, node.Value())
+		}
+		if index == l.Size() {
+			index = 0
+		}
+	}
+	return removed, nil
+}
+
+// Repeatedly removes every k-th element around the ring until one is left, as
+// in the Josephus problem.
+//
+// Counting begins at index start, which counts as the first element, and
+// resumes after each removal at the element that followed the removed one.
+// Nodes are unlinked in place, so the list ends up holding only the survivor.
+// It runs in O(n·k) time.
+//
+// Parameters:
+//   - k: The step between removals. EliminateEvery panics if k is less than 1.
+//   - start: Index of the element where counting begins.
+//
+// Returns:
+//   - []T: The removed values, in order of removal.
+//   - T: The value of the survivor, or the zero value on error.
+//   - error: An *IndexError if start is out of bounds, which also matches
+//     ErrEmptyList when the list is empty.
+//
+// Example:
+//
+//	list := list.CircularDoublyLinkedListOf(1, 2, 3, 4, 5, 6, 7)
+//	order, survivor, _ := list.EliminateEvery(3, 0)
+//	fmt.Println(order, survivor) // [3 6 2 7 5 1] 4
+func (l *CircularDoublyLinkedList[T]) EliminateEvery(k, start int) ([]T, T, error) {
+	if k < 1 {
+		panic("list: step cannot be less than 1")
+	}
+	var survivor T
+	if start < 0 || start >= l.Size() {
+		return nil, survivor, newIndexError("EliminateEvery", start, l.Size())
+	}
+	order := make([]T, 0, l.Size()-1)
+	current := l.nodeAt(start)
+	index := start
+	for l.Size() > 1 {
+		for range (k - 1) % l.Size() {
+			current = current.Next()
+			index = (index + 1) % l.Size()
+		}
+		next := current.Next()
+		l.unlink(current)
+		order = append(order, current.Value())
+		if l.observer != nil {
+			l.observer.OnRemove(index, current.Value())
+		}
+		if index == l.Size() {
+			index = 0
+		}
+		current = next
+	}
+	return order, l.Head().Value(), nil
+}
+
+// Walks forward from index start, removing elements for as long as the
+// predicate returns true.
+//
+// The walk continues past the tail to the head, and stops at the first element
+// for which the predicate returns false or once the list is empty, so every
+// element is visited at most once.
+//
+// Parameters:
+//   - start: Index of the first element to test.
+//   - predicate: Function reporting whether an element should be removed.
+//
+// Returns:
+//   - int: Number of elements removed.
+//   - error: An *IndexError if start is out of bounds.
+//
+// Example:
+//
+//	list := list.CircularDoublyLinkedListOf(1, 2, 3, 4, 5, 6)
+//	list.RemoveWhile(4, func(v int) bool { return v != 3 })
+//	fmt.Println(list.ToSlice()) // [3 4]
+func (l *CircularDoublyLinkedList[T]) RemoveWhile(start int, predicate func(T) bool) (int, error) {
+	if start < 0 || start >= l.Size() {
+		return 0, newIndexError("RemoveWhile", start, l.Size())
+	}
+	current := l.nodeAt(start)
+	removed, index := 0, start
+	for !l.IsEmpty() && predicate(current.Value()) {
+		next := current.Next()
+		l.unlink(current)
+		removed++
+		if l.observer != nil {
+			l.observer.OnRemove(index, current.Value())
+		}
+		if index == l.Size() {
+			index = 0
+		}
+		current = next
+	}
+	return removed, nil
+}
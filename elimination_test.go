@@ -0,0 +1,122 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// The elimination operations shared by both circular list types.
+type eliminatingList interface {
+	EliminateEvery(k, start int) ([]int, int, error)
+	RemoveWhile(start int, predicate func(int) bool) (int, error)
+	SetObserver(Observer[int])
+	Size() int
+	ToSlice() []int
+}
+
+func circularListsOf(values ...int) map[string]eliminatingList {
+	return map[string]eliminatingList{
+		"CircularSinglyLinkedList": CircularSinglyLinkedListOf(values...),
+		"CircularDoublyLinkedList": CircularDoublyLinkedListOf(values...),
+	}
+}
+
+func TestEliminateEvery(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		k, start int
+		order    []int
+		survivor int
+	}{
+		{"josephus", []int{1, 2, 3, 4, 5, 6, 7}, 3, 0, []int{3, 6, 2, 7, 5, 1}, 4},
+		{"offset start", []int{1, 2, 3, 4, 5}, 2, 2, []int{4, 1, 3, 2}, 5},
+		{"every element", []int{1, 2, 3, 4, 5}, 1, 3, []int{4, 5, 1, 2}, 3},
+		{"step larger than ring", []int{1, 2, 3}, 5, 0, []int{2, 3}, 1},
+		{"single element", []int{9}, 4, 0, []int{}, 9},
+	}
+	for _, test := range tests {
+		for kind, list := range circularListsOf(test.values...) {
+			t.Run(kind+"/"+test.name, func(t *testing.T) {
+				mirror := &mirrorObserver{t: t, values: list.ToSlice(), snapshot: list.ToSlice}
+				list.SetObserver(mirror)
+				order, survivor, err := list.EliminateEvery(test.k, test.start)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !slices.Equal(order, test.order) || survivor != test.survivor {
+					t.Errorf("expected %v with survivor %d, got %v with survivor %d", test.order, test.survivor, order, survivor)
+				}
+				if !slices.Equal(list.ToSlice(), []int{test.survivor}) {
+					t.Errorf("expected only the survivor to remain, got %v", list.ToSlice())
+				}
+				if !slices.Equal(mirror.values, list.ToSlice()) {
+					t.Errorf("expected the observer to mirror %v, got %v", list.ToSlice(), mirror.values)
+				}
+			})
+		}
+	}
+}
+
+func TestEliminateEveryLargeRing(t *testing.T) {
+	values := make([]int, 41)
+	for i := range values {
+		values[i] = i + 1
+	}
+	for kind, list := range circularListsOf(values...) {
+		if _, survivor, _ := list.EliminateEvery(3, 0); survivor != 31 {
+			t.Errorf("%s: expected survivor 31, got %d", kind, survivor)
+		}
+	}
+}
+
+func TestEliminateEveryErrors(t *testing.T) {
+	for kind, list := range circularListsOf() {
+		if _, _, err := list.EliminateEvery(2, 0); !errors.Is(err, ErrEmptyList) {
+			t.Errorf("%s: expected ErrEmptyList, got %v", kind, err)
+		}
+	}
+	for kind, list := range circularListsOf(1, 2, 3) {
+		if _, _, err := list.EliminateEvery(2, 3); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("%s: expected ErrIndexOutOfRange, got %v", kind, err)
+		}
+		if list.Size() != 3 {
+			t.Errorf("%s: expected the list to be unchanged, got %v", kind, list.ToSlice())
+		}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic for a step of 0", kind)
+				}
+			}()
+			list.EliminateEvery(0, 0)
+		}()
+	}
+}
+
+func TestRemoveWhile(t *testing.T) {
+	for kind, list := range circularListsOf(1, 2, 3, 4, 5, 6) {
+		mirror := &mirrorObserver{t: t, values: list.ToSlice(), snapshot: list.ToSlice}
+		list.SetObserver(mirror)
+		removed, err := list.RemoveWhile(4, func(v int) bool { return v != 3 })
+		if err != nil || removed != 4 {
+			t.Errorf("%s: expected 4 removals, got %d (%v)", kind, removed, err)
+		}
+		if !slices.Equal(list.ToSlice(), []int{3, 4}) {
+			t.Errorf("%s: expected the walk to wrap around to [3 4], got %v", kind, list.ToSlice())
+		}
+		if !slices.Equal(mirror.values, list.ToSlice()) {
+			t.Errorf("%s: expected the observer to mirror %v, got %v", kind, list.ToSlice(), mirror.values)
+		}
+		if removed, _ := list.RemoveWhile(0, func(v int) bool { return v > 3 }); removed != 0 {
+			t.Errorf("%s: expected the walk to stop at the first kept element, removed %d", kind, removed)
+		}
+		if removed, _ := list.RemoveWhile(1, func(int) bool { return true }); removed != 2 || list.Size() != 0 {
+			t.Errorf("%s: expected the whole ring to be removed, got %d removals and %v", kind, removed, list.ToSlice())
+		}
+		if _, err := list.RemoveWhile(0, func(int) bool { return true }); !errors.Is(err, ErrEmptyList) {
+			t.Errorf("%s: expected ErrEmptyList, got %v", kind, err)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors reported by list operations.
+//
+// They are meant to be matched with errors.Is, either directly or through the
+// richer *IndexError values returned by index-based operations.
+var (
+	// Reported when an index falls outside the valid range of a list.
+	ErrIndexOutOfRange = errors.New("list: index out of range")
+	// Reported when an operation requires at least one element.
+	ErrEmptyList = errors.New("list: empty list")
+	// Reported by a view whose parent list changed structurally after the
+	// view was created.
+	ErrViewInvalidated = errors.New("list: view invalidated by a structural change")
+	// Reported by JournaledList.RollbackTo for a checkpoint that was never
+	// set, or that fell out of the history.
+	ErrCheckpointNotFound = errors.New("list: checkpoint not found")
+	// Reported by a BoundedList when a value does not fit and the overflow
+	// policy does not allow making room for it.
+	ErrCapacityExceeded = errors.New("list: capacity exceeded")
+	// Reported by a BoundedList whose cost function returns a negative cost.
+	ErrInvalidCost = errors.New("list: cost must not be negative")
+	// Reported by a Balancer for a backend weight that is not positive.
+	ErrInvalidWeight = errors.New("list: weight must be positive")
+)
+
+// Describes an index-based operation that received an invalid index.
+//
+// It matches ErrIndexOutOfRange with errors.Is, and also ErrEmptyList when the
+// list had no elements at the time of the call.
+//
+// Example:
+//
+//	var indexErr *list.IndexError
+//	if errors.As(err, &indexErr) {
+//	    fmt.Println(indexErr.Op, indexErr.Index, indexErr.Size)
+//	}
+type IndexError struct {
+	// Name of the operation that failed, such as "Get" or "InsertAt".
+	Op string
+	// The index that was requested.
+	Index int
+	// The size of the list when the operation was attempted.
+	Size int
+}
+
+// Creates a new index error for the given operation.
+//
+// Parameters:
+//   - op: Name of the operation.
+//   - index: The requested index.
+//   - size: Size of the list at the time of the call.
+//
+// Returns:
+//   - *IndexError: Pointer to the new error.
+func newIndexError(op string, index, size int) *IndexError {
+	return &IndexError{Op: op, Index: index, Size: size}
+}
+
+// Returns a human-readable description of the error.
+//
+// Returns:
+//   - string: The error message.
+//
+// Example:
+//
+//	fmt.Println(err) // list: Get: index 5 out of range for size 3
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("list: %s: index %d out of range for size %d", e.Op, e.Index, e.Size)
+}
+
+// Reports whether the error matches the given target.
+//
+// Parameters:
+//   - target: The error to compare against.
+//
+// Returns:
+//   - bool: true for ErrIndexOutOfRange, and for ErrEmptyList when the list
+//     was empty.
+func (e *IndexError) Is(target error) bool {
+	switch target {
+	case ErrIndexOutOfRange:
+		return true
+	case ErrEmptyList:
+		return e.Size == 0
+	}
+	return false
+}
+
+// Validates the half-open range [from, to) against a list of the given size.
+//
+// Parameters:
+//   - op: Name of the operation, used in the returned error.
+//   - from: Start of the range (inclusive).
+//   - to: End of the range (exclusive).
+//   - size: Size of the list.
+//
+// Returns:
+//   - error: An *IndexError reporting the offending bound, or nil.
+func checkRange(op string, from, to, size int) error {
+	if from < 0 || from > size {
+		return newIndexError(op, from, size)
+	}
+	if to < from || to > size {
+		return newIndexError(op, to, size)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIndexErrorMessage(t *testing.T) {
+	err := newIndexError("Get", 5, 3)
+	want := "list: Get: index 5 out of range for size 3"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestIndexErrorIs(t *testing.T) {
+	err := error(newIndexError("Set", 2, 1))
+	if !errors.Is(err, ErrIndexOutOfRange) {
+		t.Error("expected error to match ErrIndexOutOfRange")
+	}
+	if errors.Is(err, ErrEmptyList) {
+		t.Error("did not expect non-empty error to match ErrEmptyList")
+	}
+	err = newIndexError("Get", 0, 0)
+	if !errors.Is(err, ErrEmptyList) {
+		t.Error("expected error on empty list to match ErrEmptyList")
+	}
+}
+
+func TestIndexErrorsFromAllLists(t *testing.T) {
+	singly := NewSinglyLinkedList[int]()
+	doubly := NewDoublyLinkedList[int]()
+	circularSingly := NewCircularSinglyLinkedList[int]()
+	circularDoubly := NewCircularDoublyLinkedList[int]()
+	errs := map[string][]error{
+		"SinglyLinkedList":         {singly.InsertAt(1, 0), singly.Set(0, 0), second(singly.Get(0))},
+		"DoublyLinkedList":         {doubly.InsertAt(1, 0), doubly.Set(0, 0), second(doubly.Get(0))},
+		"CircularSinglyLinkedList": {circularSingly.InsertAt(1, 0), circularSingly.Set(0, 0), second(circularSingly.Get(0))},
+		"CircularDoublyLinkedList": {circularDoubly.InsertAt(1, 0), circularDoubly.Set(0, 0), second(circularDoubly.Get(0))},
+	}
+	ops := []string{"InsertAt", "Set", "Get"}
+	for name, listErrs := range errs {
+		for i, err := range listErrs {
+			var indexErr *IndexError
+			if !errors.As(err, &indexErr) {
+				t.Errorf("%s: expected *IndexError, got %v", name, err)
+				continue
+			}
+			if indexErr.Op != ops[i] {
+				t.Errorf("%s: expected op %q, got %q", name, ops[i], indexErr.Op)
+			}
+			if !errors.Is(err, ErrIndexOutOfRange) || !errors.Is(err, ErrEmptyList) {
+				t.Errorf("%s: expected %v to match both sentinels", name, err)
+			}
+		}
+	}
+}
+
+func second[A, B any](_ A, b B) B {
+	return b
+}
@@ -0,0 +1,243 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strings"
+)
+
+// Describes how a list renders itself: its type name, the separator placed
+// between elements, and the data needed to print it.
+//
+// Every list type builds one of these so that String, Format and WriteTo share
+// a single streaming implementation.
+type listLayout[T comparable] struct {
+	name      string
+	separator string
+	size      int
+	head      T
+	tail      T
+	values    iter.Seq[T]
+}
+
+// Controls how writeList prints a list.
+//
+// The sharp flag selects the Go-syntax form for %#v, and is passed on to the
+// elements for other verbs. A negative limit means every element is printed.
+type formatOptions struct {
+	verb  rune
+	plus  bool
+	sharp bool
+	limit int
+}
+
+// The options used by String and WriteTo: the full compact form.
+var defaultFormatOptions = formatOptions{verb: 'v', limit: -1}
+
+// Wraps an io.Writer, counting the bytes written and keeping the first error.
+//
+// Once an error occurs, every further write is skipped.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+// Writes s to the underlying writer unless a previous write failed.
+func (cw *countingWriter) writeString(s string) {
+	if cw.err != nil {
+		return
+	}
+	n, err := io.WriteString(cw.w, s)
+	cw.n += int64(n)
+	cw.err = err
+}
+
+// Formats and writes the arguments unless a previous write failed.
+func (cw *countingWriter) printf(format string, args ...any) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, args...)
+	cw.n += int64(n)
+	cw.err = err
+}
+
+// Streams the representation of a list to w, element by element, without
+// building the whole string in memory.
+//
+// Parameters:
+//   - w: Destination writer.
+//   - layout: The list to print.
+//   - opts: Verb, flags and element limit.
+//
+// Returns:
+//   - int64: Number of bytes written.
+//   - error: The first error returned by w, if any.
+func writeList[T comparable](w io.Writer, layout listLayout[T], opts formatOptions) (int64, error) {
+	cw := &countingWriter{w: w}
+	if opts.sharp && opts.verb == 'v' {
+		// A call to the list's Of constructor, which rebuilds the list.
+		cw.printf("list.%sOf[%s](", layout.name, reflect.TypeFor[T]().String())
+		writeElements(cw, layout, "%#v", ", ", "...", opts.limit)
+		cw.writeString(")")
+		return cw.n, cw.err
+	}
+	elementFormat := "%" + string(opts.verb)
+	switch {
+	case opts.verb == 's':
+		elementFormat = "%v"
+	case opts.sharp:
+		elementFormat = "%#" + string(opts.verb)
+	}
+	cw.writeString(layout.name)
+	if opts.plus {
+		if layout.size == 0 {
+			cw.writeString("(size=0)")
+		} else {
+			cw.printf("(size=%d, head="+elementFormat+", tail="+elementFormat+")", layout.size, layout.head, layout.tail)
+		}
+	}
+	cw.writeString(": ")
+	if layout.size == 0 {
+		cw.writeString("[]")
+		return cw.n, cw.err
+	}
+	writeElements(cw, layout, "["+elementFormat+"]", layout.separator, "...", opts.limit)
+	return cw.n, cw.err
+}
+
+// Writes at most limit elements of the list using elementFormat, separated by
+// separator, followed by ellipsis when elements were left out.
+func writeElements[T comparable](cw *countingWriter, layout listLayout[T], elementFormat, separator, ellipsis string, limit int) {
+	i := 0
+	for value := range layout.values {
+		if cw.err != nil {
+			return
+		}
+		if limit >= 0 && i == limit {
+			if i > 0 {
+				cw.writeString(separator)
+			}
+			cw.writeString(ellipsis)
+			return
+		}
+		if i > 0 {
+			cw.writeString(separator)
+		}
+		cw.printf(elementFormat, value)
+		i++
+	}
+}
+
+// Implements fmt.Formatter on behalf of a list.
+//
+// The precision, or the width when no precision is given, limits how many
+// elements are printed.
+func formatList[T comparable](f fmt.State, verb rune, layout listLayout[T]) {
+	opts := formatOptions{verb: verb, plus: f.Flag('+'), sharp: f.Flag('#'), limit: -1}
+	if precision, ok := f.Precision(); ok {
+		opts.limit = precision
+	} else if width, ok := f.Width(); ok {
+		opts.limit = width
+	}
+	writeList(f, layout, opts)
+}
+
+// Returns the complete compact representation of a list as a string.
+func listString[T comparable](layout listLayout[T]) string {
+	var b strings.Builder
+	writeList(&b, layout, defaultFormatOptions)
+	return b.String()
+}
@@ -0,0 +1,150 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFormatCompactMatchesString(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	got := fmt.Sprintf("%v", list)
+	if got != list.String() {
+		t.Errorf("expected %q, got %q", list.String(), got)
+	}
+	got = fmt.Sprint(list)
+	if got != "SinglyLinkedList: [1] -> [2]" {
+		t.Errorf("expected %q, got %q", "SinglyLinkedList: [1] -> [2]", got)
+	}
+}
+
+func TestFormatPlusIncludesSizeAndEnds(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	got := fmt.Sprintf("%+v", list)
+	want := "DoublyLinkedList(size=3, head=1, tail=3): [1] ↔ [2] ↔ [3]"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	empty := NewDoublyLinkedList[int]()
+	got = fmt.Sprintf("%+v", empty)
+	want = "DoublyLinkedList(size=0): []"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestFormatGoSyntax(t *testing.T) {
+	list := NewCircularSinglyLinkedList[string]()
+	list.Append("a")
+	list.Append("b")
+	got := fmt.Sprintf("%#v", list)
+	want := `list.CircularSinglyLinkedListOf[string]("a", "b")`
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestFormatGoSyntaxIsValidGo(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2)
+	if got, want := fmt.Sprintf("%#v", list), "list.DoublyLinkedListOf[int](1, 2)"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got, want := fmt.Sprintf("%#v", NewSinglyLinkedList[int]()), "list.SinglyLinkedListOf[int]()"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestFormatSharpFlagReachesElements(t *testing.T) {
+	list := DoublyLinkedListOf(10, 255)
+	if got, want := fmt.Sprintf("%#x", list), "DoublyLinkedList: [0xa] ↔ [0xff]"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestFormatPrecisionTruncates(t *testing.T) {
+	list := NewCircularDoublyLinkedList[int]()
+	for i := range 5 {
+		list.Append(i)
+	}
+	got := fmt.Sprintf("%.2v", list)
+	want := "CircularDoublyLinkedList: [0] <-> [1] <-> ..."
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	got = fmt.Sprintf("%3v", list)
+	want = "CircularDoublyLinkedList: [0] <-> [1] <-> [2] <-> ..."
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	got = fmt.Sprintf("%#.1v", list)
+	want = "list.CircularDoublyLinkedListOf[int](0, ...)"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	got = fmt.Sprintf("%.5v", list)
+	if got != list.String() {
+		t.Errorf("expected %q, got %q", list.String(), got)
+	}
+}
+
+func TestFormatElementVerb(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(10)
+	list.Append(255)
+	got := fmt.Sprintf("%x", list)
+	want := "SinglyLinkedList: [a] -> [ff]"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	var b strings.Builder
+	n, err := list.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.String() != list.String() {
+		t.Errorf("expected %q, got %q", list.String(), b.String())
+	}
+	if n != int64(b.Len()) {
+		t.Errorf("expected %d bytes written, got %d", b.Len(), n)
+	}
+}
+
+type failingWriter struct{ remaining int }
+
+var errWriterFull = errors.New("writer full")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.remaining {
+		n := w.remaining
+		w.remaining = 0
+		return n, errWriterFull
+	}
+	w.remaining -= len(p)
+	return len(p), nil
+}
+
+func TestWriteToStopsOnError(t *testing.T) {
+	list := NewCircularSinglyLinkedList[int]()
+	for i := range 100 {
+		list.Append(i)
+	}
+	n, err := list.WriteTo(&failingWriter{remaining: 30})
+	if !errors.Is(err, errWriterFull) {
+		t.Errorf("expected errWriterFull, got %v", err)
+	}
+	if n != 30 {
+		t.Errorf("expected 30 bytes written, got %d", n)
+	}
+}
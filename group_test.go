@@ -0,0 +1,41 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	words := CircularSinglyLinkedListOf("apple", "bee", "avocado", "cat", "banana")
+	groups := GroupBy(words, func(word string) byte { return word[0] })
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(groups))
+	}
+	if !slices.Equal(groups['a'].ToSlice(), []string{"apple", "avocado"}) {
+		t.Errorf("expected [apple avocado], got %v", groups['a'].ToSlice())
+	}
+	if !slices.Equal(groups['b'].ToSlice(), []string{"bee", "banana"}) {
+		t.Errorf("expected [bee banana], got %v", groups['b'].ToSlice())
+	}
+	if words.Size() != 5 {
+		t.Errorf("expected input to be left unchanged, got size %d", words.Size())
+	}
+}
+
+func TestGroupByAllListKinds(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	results := []map[bool]*DoublyLinkedList[int]{
+		GroupBy(SinglyLinkedListOf(1, 2, 3, 4), isEven),
+		GroupBy(DoublyLinkedListOf(1, 2, 3, 4), isEven),
+		GroupBy(CircularSinglyLinkedListOf(1, 2, 3, 4), isEven),
+		GroupBy(CircularDoublyLinkedListOf(1, 2, 3, 4), isEven),
+	}
+	for i, groups := range results {
+		if !slices.Equal(groups[true].ToSlice(), []int{2, 4}) || !slices.Equal(groups[false].ToSlice(), []int{1, 3}) {
+			t.Errorf("list kind %d: unexpected groups %v and %v", i, groups[true], groups[false])
+		}
+	}
+	if groups := GroupBy(NewSinglyLinkedList[int](), isEven); len(groups) != 0 {
+		t.Errorf("expected no groups, got %d", len(groups))
+	}
+}
@@ -0,0 +1,659 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "fmt"
+
+// A DoublyLinkedList that records every mutation with its inverse, so that
+// edits can be undone and redone.
+//
+// Each mutating call is one history entry, and Transaction groups several
+// calls into a single entry. Checkpoint names a point in the history that
+// RollbackTo can return to. When a history limit is set, the oldest entries
+// are discarded once it is exceeded.
+//
+// The wrapped list is not exposed for writing; ReadOnly gives access to its
+// contents.
+type JournaledList[T comparable] struct {
+	list *DoublyLinkedList[T]
+	// Edits recorded since the last committed entry.
+	pending []edit[T]
+	undo    [][]edit[T]
+	redo    [][]edit[T]
+	// Number of entries discarded from the bottom of the undo stack, so that
+	// base+len(undo) is the absolute position of the current state.
+	base        int
+	limit       int
+	checkpoints map[string]int
+	// Depth of nested Transaction calls.
+	depth int
+}
+
+// The kinds of primitive edits a history entry is made of.
+type editKind int
+
+const (
+	editInsert editKind = iota
+	editRemove
+	editSet
+	editReverse
+)
+
+// A primitive edit, with enough information to apply it in either direction.
+type edit[T comparable] struct {
+	kind     editKind
+	index    int
+	oldValue T
+	newValue T
+	// Half-open range of an editReverse.
+	from, to int
+}
+
+// Records the notifications of the wrapped list as edits.
+type journalRecorder[T comparable] struct {
+	journal *JournaledList[T]
+}
+
+// Records the insertion of value at index as a pending edit.
+func (r journalRecorder[T]) OnInsert(index int, value T) {
+	r.journal.pending = append(r.journal.pending, edit[T]{kind: editInsert, index: index, newValue: value})
+}
+
+// Records the removal of value from index as a pending edit.
+func (r journalRecorder[T]) OnRemove(index int, value T) {
+	r.journal.pending = append(r.journal.pending, edit[T]{kind: editRemove, index: index, oldValue: value})
+}
+
+// Records the replacement of oldValue by newValue at index as a pending edit.
+func (r journalRecorder[T]) OnSet(index int, oldValue, newValue T) {
+	r.journal.pending = append(r.journal.pending, edit[T]{kind: editSet, index: index, oldValue: oldValue, newValue: newValue})
+}
+
+// Reorders are recorded by the JournaledList methods themselves, which know
+// the range that was reversed.
+func (r journalRecorder[T]) OnReorder() {}
+
+// Creates an empty journaled list.
+//
+// Parameters:
+//   - historyLimit: Maximum number of entries kept for Undo; 0 or less keeps
+//     the whole history.
+//
+// Returns:
+//   - *JournaledList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	doc := list.NewJournaledList[string](100)
+func NewJournaledList[T comparable](historyLimit int) *JournaledList[T] {
+	j := &JournaledList[T]{
+		list:        NewDoublyLinkedList[T](),
+		limit:       historyLimit,
+		checkpoints: make(map[string]int),
+	}
+	j.list.SetObserver(journalRecorder[T]{journal: j})
+	return j
+}
+
+// Returns the number of elements in the list.
+//
+// Returns:
+//   - int: Number of elements.
+//
+// Example:
+//
+//	fmt.Println(doc.Size())
+func (j *JournaledList[T]) Size() int {
+	return j.list.Size()
+}
+
+// Returns a live read-only view of the list's contents.
+//
+// Returns:
+//   - *ReadOnly[T]: A read-only wrapper around the underlying list.
+//
+// Example:
+//
+//	for v := range doc.ReadOnly().All() {
+//	    fmt.Println(v)
+//	}
+func (j *JournaledList[T]) ReadOnly() *ReadOnly[T] {
+	return j.list.ReadOnly()
+}
+
+// Returns a slice containing the elements of the list.
+//
+// Returns:
+//   - []T: Slice of the elements, from head to tail.
+//
+// Example:
+//
+//	values := doc.ToSlice()
+func (j *JournaledList[T]) ToSlice() []T {
+	return j.list.ToSlice()
+}
+
+// Returns the representation of the underlying list.
+//
+// Returns:
+//   - string: The formatted list.
+//
+// Example:
+//
+//	fmt.Println(doc)
+func (j *JournaledList[T]) String() string {
+	return j.list.String()
+}
+
+// Appends a value at the end of the list, as one history entry.
+//
+// Parameters:
+//   - value: The value to append.
+//
+// Example:
+//
+//	doc.Append("line")
+func (j *JournaledList[T]) Append(value T) {
+	j.list.Append(value)
+	j.commit()
+}
+
+// Appends several values at the end of the list, as one history entry.
+//
+// Parameters:
+//   - values: The values to append, in order.
+//
+// Example:
+//
+//	doc.AppendAll("a", "b", "c")
+func (j *JournaledList[T]) AppendAll(values ...T) {
+	j.list.AppendAll(values...)
+	j.commit()
+}
+
+// Inserts a value at the beginning of the list, as one history entry.
+//
+// Parameters:
+//   - value: The value to insert.
+//
+// Example:
+//
+//	doc.Prepend("title")
+func (j *JournaledList[T]) Prepend(value T) {
+	j.list.Prepend(value)
+	j.commit()
+}
+
+// Inserts a value at the specified index, as one history entry.
+//
+// Parameters:
+//   - index: Position at which to insert (0-based).
+//   - value: The value to insert.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := doc.InsertAt(1, "line")
+func (j *JournaledList[T]) InsertAt(index int, value T) error {
+	err := j.list.InsertAt(index, value)
+	j.commit()
+	return err
+}
+
+// Removes the first element, as one history entry.
+//
+// Returns:
+//   - bool: True if an element was removed.
+//
+// Example:
+//
+//	doc.RemoveFirst()
+func (j *JournaledList[T]) RemoveFirst() bool {
+	removed := j.list.RemoveFirst()
+	j.commit()
+	return removed
+}
+
+// Removes the last element, as one history entry.
+//
+// Returns:
+//   - bool: True if an element was removed.
+//
+// Example:
+//
+//	doc.RemoveLast()
+func (j *JournaledList[T]) RemoveLast() bool {
+	removed := j.list.RemoveLast()
+	j.commit()
+	return removed
+}
+
+// Removes the first occurrence of value, as one history entry.
+//
+// Parameters:
+//   - value: The value to remove.
+//
+// Returns:
+//   - bool: True if the value was found and removed.
+//
+// Example:
+//
+//	doc.Remove("draft")
+func (j *JournaledList[T]) Remove(value T) bool {
+	removed := j.list.Remove(value)
+	j.commit()
+	return removed
+}
+
+// Removes the element at the specified index, as one history entry.
+//
+// Parameters:
+//   - index: Position of the element to remove (0-based).
+//
+// Returns:
+//   - T: The removed value.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := doc.RemoveAt(2)
+func (j *JournaledList[T]) RemoveAt(index int) (T, error) {
+	value, err := j.list.RemoveAt(index)
+	j.commit()
+	return value, err
+}
+
+// Removes every element matching the predicate, as one history entry.
+//
+// Parameters:
+//   - predicate: Reports whether an element should be removed.
+//
+// Returns:
+//   - int: Number of removed elements.
+//
+// Example:
+//
+//	doc.RemoveIf(func(s string) bool { return s == "" })
+func (j *JournaledList[T]) RemoveIf(predicate func(T) bool) int {
+	removed := j.list.RemoveIf(predicate)
+	j.commit()
+	return removed
+}
+
+// Updates the value at the specified index, as one history entry.
+//
+// Parameters:
+//   - index: Position of the element (0-based).
+//   - value: The new value.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := doc.Set(0, "Title")
+func (j *JournaledList[T]) Set(index int, value T) error {
+	err := j.list.Set(index, value)
+	j.commit()
+	return err
+}
+
+// Reverses the whole list, as one history entry.
+//
+// Example:
+//
+//	doc.Reverse()
+func (j *JournaledList[T]) Reverse() {
+	j.ReverseRange(0, j.list.Size())
+}
+
+// Reverses the elements in the half-open range [from, to), as one history
+// entry.
+//
+// Parameters:
+//   - from: Index of the first element to reverse.
+//   - to: Index one past the last element to reverse.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := doc.ReverseRange(1, 4)
+func (j *JournaledList[T]) ReverseRange(from, to int) error {
+	if err := j.list.ReverseRange(from, to); err != nil {
+		return err
+	}
+	if to-from > 1 {
+		j.pending = append(j.pending, edit[T]{kind: editReverse, from: from, to: to})
+	}
+	j.commit()
+	return nil
+}
+
+// Removes every element, as one history entry.
+//
+// Example:
+//
+//	doc.Clear()
+func (j *JournaledList[T]) Clear() {
+	j.list.Clear()
+	j.commit()
+}
+
+// Runs fn and records every mutation it makes as a single history entry.
+//
+// If fn returns an error or panics, its mutations are reverted and nothing is
+// recorded; a panic then carries on unwinding.
+// Transactions may be nested; a nested transaction that fails only reverts its
+// own mutations. Undo, Redo, Checkpoint and RollbackTo must not be called from
+// fn.
+//
+// Parameters:
+//   - fn: The function performing the mutations.
+//
+// Returns:
+//   - error: The error returned by fn.
+//
+// Example:
+//
+//	err := doc.Transaction(func() error {
+//	    doc.RemoveAt(0)
+//	    return doc.InsertAt(3, "moved")
+//	})
+func (j *JournaledList[T]) Transaction(fn func() error) (err error) {
+	start := len(j.pending)
+	j.depth++
+	returned := false
+	defer func() {
+		j.depth--
+		if !returned || err != nil {
+			j.revert(j.pending[start:])
+			j.pending = j.pending[:start]
+		}
+		j.commit()
+	}()
+	err = fn()
+	returned = true
+	return err
+}
+
+// Reverts the most recent history entry.
+//
+// Returns:
+//   - bool: True if there was an entry to undo.
+//
+// Example:
+//
+//	for doc.Undo() {
+//	}
+func (j *JournaledList[T]) Undo() bool {
+	j.checkNoTransaction("Undo")
+	if len(j.undo) == 0 {
+		return false
+	}
+	entry := j.undo[len(j.undo)-1]
+	j.undo = j.undo[:len(j.undo)-1]
+	j.revert(entry)
+	j.redo = append(j.redo, entry)
+	return true
+}
+
+// Reapplies the most recently undone entry.
+//
+// Recording a new mutation after Undo discards the entries that could have
+// been redone.
+//
+// Returns:
+//   - bool: True if there was an entry to redo.
+//
+// Example:
+//
+//	doc.Undo()
+//	doc.Redo()
+func (j *JournaledList[T]) Redo() bool {
+	j.checkNoTransaction("Redo")
+	if len(j.redo) == 0 {
+		return false
+	}
+	entry := j.redo[len(j.redo)-1]
+	j.redo = j.redo[:len(j.redo)-1]
+	j.replay(entry)
+	j.undo = append(j.undo, entry)
+	return true
+}
+
+// Reports whether Undo would revert an entry.
+//
+// Returns:
+//   - bool: True if the undo history is not empty.
+//
+// Example:
+//
+//	undoButton.SetEnabled(doc.CanUndo())
+func (j *JournaledList[T]) CanUndo() bool {
+	return len(j.undo) > 0
+}
+
+// Reports whether Redo would reapply an entry.
+//
+// Returns:
+//   - bool: True if the redo history is not empty.
+//
+// Example:
+//
+//	redoButton.SetEnabled(doc.CanRedo())
+func (j *JournaledList[T]) CanRedo() bool {
+	return len(j.redo) > 0
+}
+
+// Names the current state so that RollbackTo can return to it, replacing any
+// checkpoint with the same name.
+//
+// Parameters:
+//   - name: The checkpoint name.
+//
+// Example:
+//
+//	doc.Checkpoint("saved")
+func (j *JournaledList[T]) Checkpoint(name string) {
+	j.checkNoTransaction("Checkpoint")
+	j.checkpoints[name] = j.position()
+}
+
+// Restores the state named by a checkpoint, undoing or redoing entries as
+// needed.
+//
+// A checkpoint is lost when the entries leading to it are discarded, either
+// because they fell out of a bounded history or because new mutations were
+// recorded after undoing past it.
+//
+// Parameters:
+//   - name: The checkpoint name.
+//
+// Returns:
+//   - error: ErrCheckpointNotFound if the checkpoint is unknown or lost.
+//
+// Example:
+//
+//	if err := doc.RollbackTo("saved"); err != nil {
+//	    return err
+//	}
+func (j *JournaledList[T]) RollbackTo(name string) error {
+	j.checkNoTransaction("RollbackTo")
+	target, ok := j.checkpoints[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrCheckpointNotFound, name)
+	}
+	for j.position() > target {
+		j.Undo()
+	}
+	for j.position() < target {
+		j.Redo()
+	}
+	return nil
+}
+
+// Returns the absolute position of the current state in the history.
+func (j *JournaledList[T]) position() int {
+	return j.base + len(j.undo)
+}
+
+// Turns the pending edits into a history entry, unless a transaction is
+// still open.
+func (j *JournaledList[T]) commit() {
+	if j.depth > 0 || len(j.pending) == 0 {
+		return
+	}
+	for name, position := range j.checkpoints {
+		if position > j.position() {
+			delete(j.checkpoints, name)
+		}
+	}
+	j.undo = append(j.undo, j.pending)
+	j.redo = nil
+	j.pending = nil
+	if j.limit > 0 && len(j.undo) > j.limit {
+		dropped := len(j.undo) - j.limit
+		j.undo = j.undo[dropped:]
+		j.base += dropped
+		for name, position := range j.checkpoints {
+			if position < j.base {
+				delete(j.checkpoints, name)
+			}
+		}
+	}
+}
+
+// Applies the inverse of the edits, last first, without recording them.
+func (j *JournaledList[T]) revert(edits []edit[T]) {
+	j.list.SetObserver(nil)
+	defer j.list.SetObserver(journalRecorder[T]{journal: j})
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		switch e.kind {
+		case editInsert:
+			j.list.RemoveAt(e.index)
+		case editRemove:
+			j.list.InsertAt(e.index, e.oldValue)
+		case editSet:
+			j.list.Set(e.index, e.oldValue)
+		case editReverse:
+			j.list.ReverseRange(e.from, e.to)
+		}
+	}
+}
+
+// Applies the edits again, in order, without recording them.
+func (j *JournaledList[T]) replay(edits []edit[T]) {
+	j.list.SetObserver(nil)
+	defer j.list.SetObserver(journalRecorder[T]{journal: j})
+	for _, e := range edits {
+		switch e.kind {
+		case editInsert:
+			j.list.InsertAt(e.index, e.newValue)
+		case editRemove:
+			j.list.RemoveAt(e.index)
+		case editSet:
+			j.list.Set(e.index, e.newValue)
+		case editReverse:
+			j.list.ReverseRange(e.from, e.to)
+		}
+	}
+}
+
+// Panics if called from inside a Transaction.
+func (j *JournaledList[T]) checkNoTransaction(op string) {
+	if j.depth > 0 {
+		panic("list: " + op + " called inside a transaction")
+	}
+}
@@ -0,0 +1,219 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestJournaledListUndoRedo(t *testing.T) {
+	j := NewJournaledList[int](0)
+	j.AppendAll(1, 2, 3)
+	j.Prepend(0)
+	j.Set(1, 10)
+	j.RemoveAt(2)
+	j.Reverse()
+	j.RemoveIf(func(v int) bool { return v == 0 })
+	states := [][]int{
+		{1, 2, 3},
+		{0, 1, 2, 3},
+		{0, 10, 2, 3},
+		{0, 10, 3},
+		{3, 10, 0},
+		{3, 10},
+	}
+	for i := len(states) - 1; i > 0; i-- {
+		if !slices.Equal(j.ToSlice(), states[i]) {
+			t.Fatalf("expected %v, got %v", states[i], j.ToSlice())
+		}
+		if !j.Undo() {
+			t.Fatal("expected Undo to succeed")
+		}
+	}
+	j.Undo()
+	if j.Size() != 0 || j.Undo() || j.CanUndo() {
+		t.Fatalf("expected an empty list with no history, got %v", j.ToSlice())
+	}
+	for _, state := range states {
+		if !j.Redo() {
+			t.Fatal("expected Redo to succeed")
+		}
+		if !slices.Equal(j.ToSlice(), state) {
+			t.Fatalf("expected %v, got %v", state, j.ToSlice())
+		}
+	}
+	if j.Redo() || j.CanRedo() {
+		t.Error("expected nothing left to redo")
+	}
+}
+
+func TestJournaledListNewEditDiscardsRedo(t *testing.T) {
+	j := NewJournaledList[string](0)
+	j.Append("a")
+	j.Append("b")
+	j.Undo()
+	j.Append("c")
+	if j.CanRedo() {
+		t.Error("expected a new edit to discard the redo history")
+	}
+	if !slices.Equal(j.ToSlice(), []string{"a", "c"}) {
+		t.Errorf("expected [a c], got %v", j.ToSlice())
+	}
+}
+
+func TestJournaledListFailedOperationsAreNotRecorded(t *testing.T) {
+	j := NewJournaledList[int](0)
+	if err := j.InsertAt(3, 1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+	j.RemoveFirst()
+	j.Remove(7)
+	if j.CanUndo() {
+		t.Error("expected operations that changed nothing to leave no history")
+	}
+}
+
+func TestJournaledListCheckpoints(t *testing.T) {
+	j := NewJournaledList[int](0)
+	j.AppendAll(1, 2)
+	j.Checkpoint("start")
+	j.Append(3)
+	j.Checkpoint("three")
+	j.Clear()
+	if err := j.RollbackTo("start"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(j.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", j.ToSlice())
+	}
+	if err := j.RollbackTo("three"); err != nil {
+		t.Fatalf("expected to roll forward, got %v", err)
+	}
+	if !slices.Equal(j.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", j.ToSlice())
+	}
+	j.RollbackTo("start")
+	j.Append(4)
+	if err := j.RollbackTo("three"); !errors.Is(err, ErrCheckpointNotFound) {
+		t.Errorf("expected a diverged checkpoint to be lost, got %v", err)
+	}
+	if err := j.RollbackTo("missing"); !errors.Is(err, ErrCheckpointNotFound) {
+		t.Errorf("expected ErrCheckpointNotFound, got %v", err)
+	}
+}
+
+func TestJournaledListTransactions(t *testing.T) {
+	j := NewJournaledList[int](0)
+	j.AppendAll(1, 2, 3)
+	err := j.Transaction(func() error {
+		j.RemoveFirst()
+		j.Append(4)
+		return j.Transaction(func() error {
+			j.Set(0, 20)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(j.ToSlice(), []int{20, 3, 4}) {
+		t.Fatalf("expected [20 3 4], got %v", j.ToSlice())
+	}
+	j.Undo()
+	if !slices.Equal(j.ToSlice(), []int{1, 2, 3}) {
+		t.Fatalf("expected the transaction to undo as one entry, got %v", j.ToSlice())
+	}
+
+	failure := errors.New("failure")
+	err = j.Transaction(func() error {
+		j.Append(9)
+		j.Transaction(func() error {
+			j.Append(10)
+			return failure
+		})
+		j.Reverse()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(j.ToSlice(), []int{9, 3, 2, 1}) {
+		t.Fatalf("expected only the failed nested transaction to be reverted, got %v", j.ToSlice())
+	}
+
+	before := j.ToSlice()
+	if err := j.Transaction(func() error {
+		j.Clear()
+		return failure
+	}); !errors.Is(err, failure) {
+		t.Fatalf("expected the transaction error, got %v", err)
+	}
+	if !slices.Equal(j.ToSlice(), before) {
+		t.Errorf("expected a failed transaction to be reverted, got %v", j.ToSlice())
+	}
+}
+
+func TestJournaledListUndoInsideTransactionPanics(t *testing.T) {
+	j := NewJournaledList[int](0)
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Undo inside a transaction to panic")
+		}
+	}()
+	j.Transaction(func() error {
+		j.Undo()
+		return nil
+	})
+}
+
+func TestJournaledListBoundedHistory(t *testing.T) {
+	j := NewJournaledList[int](2)
+	j.Append(1)
+	j.Checkpoint("one")
+	j.Append(2)
+	j.Append(3)
+	j.Append(4)
+	undone := 0
+	for j.Undo() {
+		undone++
+	}
+	if undone != 2 {
+		t.Errorf("expected 2 undoable entries, got %d", undone)
+	}
+	if !slices.Equal(j.ToSlice(), []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", j.ToSlice())
+	}
+	if err := j.RollbackTo("one"); !errors.Is(err, ErrCheckpointNotFound) {
+		t.Errorf("expected a trimmed checkpoint to be lost, got %v", err)
+	}
+}
+
+func TestJournaledListTransactionPanic(t *testing.T) {
+	j := NewJournaledList[int](0)
+	j.AppendAll(1, 2, 3)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected the panic to reach the caller")
+			}
+		}()
+		j.Transaction(func() error {
+			j.RemoveFirst()
+			j.Append(4)
+			panic("boom")
+		})
+	}()
+	if !slices.Equal(j.ToSlice(), []int{1, 2, 3}) {
+		t.Fatalf("expected the panicking transaction to be reverted, got %v", j.ToSlice())
+	}
+	j.Append(5)
+	if !j.Undo() || !slices.Equal(j.ToSlice(), []int{1, 2, 3}) {
+		t.Fatalf("expected Undo to revert only the append, got %v", j.ToSlice())
+	}
+	if !j.Undo() || !slices.Equal(j.ToSlice(), []int{}) || j.CanUndo() {
+		t.Errorf("expected Undo to revert the initial AppendAll, got %v", j.ToSlice())
+	}
+	if !j.Redo() || !slices.Equal(j.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected Redo to work after the panic, got %v", j.ToSlice())
+	}
+}
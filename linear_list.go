@@ -0,0 +1,171 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "iter"
+
+// Constrains generic functions to the linear list types, SinglyLinkedList and
+// DoublyLinkedList.
+//
+// L is the list pointer type itself, so that functions can build and return
+// lists of the same kind as their inputs.
+type LinearList[L any, T comparable] interface {
+	*SinglyLinkedList[T] | *DoublyLinkedList[T]
+	Size() int
+	IsEmpty() bool
+	Append(T)
+	Prepend(T)
+	RemoveFirst() bool
+	RemoveLast() bool
+	Clear()
+	String() string
+	values() iter.Seq[T]
+	newLike() L
+	first() T
+	last() T
+	moveFirstTo(dst L)
+}
+
+// Returns a new empty list of the same kind. Safe to call on a nil receiver.
+func (l *SinglyLinkedList[T]) newLike() *SinglyLinkedList[T] {
+	return NewSinglyLinkedList[T]()
+}
+
+// Returns the value at the head. The list must not be empty.
+func (l *SinglyLinkedList[T]) first() T {
+	return l.Head().Value()
+}
+
+// Returns the value at the tail. The list must not be empty.
+func (l *SinglyLinkedList[T]) last() T {
+	return l.Tail().Value()
+}
+
+// Unlinks the head node and links it at the end of dst. The list must not be
+// empty.
+func (l *SinglyLinkedList[T]) moveFirstTo(dst *SinglyLinkedList[T]) {
+	node := l.unlinkAfter(nil)
+	dst.linkAfter(dst.Tail(), node, node, 1)
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	if dst.observer != nil {
+		dst.observer.OnInsert(dst.size-1, node.Value())
+	}
+}
+
+// Returns a new empty list of the same kind. Safe to call on a nil receiver.
+func (l *DoublyLinkedList[T]) newLike() *DoublyLinkedList[T] {
+	return NewDoublyLinkedList[T]()
+}
+
+// Returns the value at the head. The list must not be empty.
+func (l *DoublyLinkedList[T]) first() T {
+	return l.Head().Value()
+}
+
+// Returns the value at the tail. The list must not be empty.
+func (l *DoublyLinkedList[T]) last() T {
+	return l.Tail().Value()
+}
+
+// Unlinks the head node and links it at the end of dst. The list must not be
+// empty.
+func (l *DoublyLinkedList[T]) moveFirstTo(dst *DoublyLinkedList[T]) {
+	node := l.Head()
+	l.unlink(node)
+	dst.linkAfter(dst.Tail(), node, node, 1)
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	if dst.observer != nil {
+		dst.observer.OnInsert(dst.size-1, node.Value())
+	}
+}
@@ -0,0 +1,226 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"container/heap"
+	"iter"
+)
+
+// The head element of one input during a k-way merge.
+type mergeItem[T any] struct {
+	value  T
+	source int
+}
+
+// A min-heap of merge items implementing heap.Interface.
+//
+// Ties are broken by source index so that merges are stable.
+type mergeHeap[T any] struct {
+	items []mergeItem[T]
+	cmp   func(x, y T) int
+}
+
+// Returns the number of pending items.
+func (h *mergeHeap[T]) Len() int {
+	return len(h.items)
+}
+
+// Reports whether item i should be merged before item j.
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	if c := h.cmp(h.items[i].value, h.items[j].value); c != 0 {
+		return c < 0
+	}
+	return h.items[i].source < h.items[j].source
+}
+
+// Swaps items i and j.
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+// Appends an item. Called by container/heap.
+func (h *mergeHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(mergeItem[T]))
+}
+
+// Removes and returns the last item. Called by container/heap.
+func (h *mergeHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// Merges any number of sorted lists into a single sorted list in O(n log k),
+// where k is the number of lists.
+//
+// The nodes of the inputs are relinked into the result rather than copied, so
+// every input is left empty. The merge is stable: equal elements keep the order
+// of the lists they came from.
+//
+// Parameters:
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//   - lists: The sorted lists to merge, all of the same kind.
+//
+// Returns:
+//   - L: A list of the same kind as the inputs holding every element.
+//
+// Example:
+//
+//	merged := list.MergeK(cmp.Compare[int], a, b, c)
+func MergeK[L LinearList[L, T], T comparable](cmp func(x, y T) int, lists ...L) L {
+	var zero L
+	out := zero.newLike()
+	h := &mergeHeap[T]{items: make([]mergeItem[T], 0, len(lists)), cmp: cmp}
+	for i, l := range lists {
+		if !l.IsEmpty() {
+			h.items = append(h.items, mergeItem[T]{value: l.first(), source: i})
+		}
+	}
+	heap.Init(h)
+	for h.Len() > 0 {
+		source := lists[h.items[0].source]
+		source.moveFirstTo(out)
+		if source.IsEmpty() {
+			heap.Pop(h)
+		} else {
+			h.items[0].value = source.first()
+			heap.Fix(h, 0)
+		}
+	}
+	return out
+}
+
+// Returns an iterator yielding the elements of any number of sorted lists in
+// sorted order.
+//
+// The inputs are read lazily, one element at a time, and are not modified; the
+// iterator holds at most one pending element per list. Like MergeK, the merge
+// is stable.
+//
+// Parameters:
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//   - lists: The sorted lists to merge, all of the same kind.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the merged elements.
+//
+// Example:
+//
+//	for v := range list.MergeKSeq(cmp.Compare[int], a, b, c) {
+//	    fmt.Println(v)
+//	}
+func MergeKSeq[L LinearList[L, T], T comparable](cmp func(x, y T) int, lists ...L) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		h := &mergeHeap[T]{items: make([]mergeItem[T], 0, len(lists)), cmp: cmp}
+		nexts := make([]func() (T, bool), len(lists))
+		for i, l := range lists {
+			next, stop := iter.Pull(l.values())
+			defer stop()
+			nexts[i] = next
+			if value, ok := next(); ok {
+				h.items = append(h.items, mergeItem[T]{value: value, source: i})
+			}
+		}
+		heap.Init(h)
+		for h.Len() > 0 {
+			item := h.items[0]
+			if !yield(item.value) {
+				return
+			}
+			if value, ok := nexts[item.source](); ok {
+				h.items[0].value = value
+				heap.Fix(h, 0)
+			} else {
+				heap.Pop(h)
+			}
+		}
+	}
+}
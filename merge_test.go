@@ -0,0 +1,75 @@
+package list
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestMergeKDoublyLinkedLists(t *testing.T) {
+	a := DoublyLinkedListOf(1, 4, 7)
+	b := DoublyLinkedListOf(2, 5, 8, 9)
+	c := NewDoublyLinkedList[int]()
+	d := DoublyLinkedListOf(0, 3, 6)
+	merged := MergeK(cmp.Compare[int], a, b, c, d)
+	if !slices.Equal(merged.ToSlice(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("expected [0 ... 9], got %v", merged.ToSlice())
+	}
+	if !a.IsEmpty() || !b.IsEmpty() || !d.IsEmpty() {
+		t.Error("expected inputs to be left empty")
+	}
+	backward := []int{}
+	for current := merged.Tail(); current != nil; current = current.Prev() {
+		backward = append(backward, current.Value())
+	}
+	if len(backward) != 10 || backward[0] != 9 || backward[9] != 0 {
+		t.Errorf("expected prev links to be consistent, got %v", backward)
+	}
+}
+
+func TestMergeKSinglyLinkedListsIsStable(t *testing.T) {
+	type event struct {
+		time   int
+		source string
+	}
+	byTime := func(x, y event) int { return cmp.Compare(x.time, y.time) }
+	a := SinglyLinkedListOf(event{1, "a"}, event{2, "a"})
+	b := SinglyLinkedListOf(event{1, "b"}, event{3, "b"})
+	merged := MergeK(byTime, a, b)
+	expected := []event{{1, "a"}, {1, "b"}, {2, "a"}, {3, "b"}}
+	if !slices.Equal(merged.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, merged.ToSlice())
+	}
+	if merged.Tail().Value() != (event{3, "b"}) || merged.Tail().Next() != nil {
+		t.Error("expected tail to be the last event")
+	}
+}
+
+func TestMergeKWithoutLists(t *testing.T) {
+	merged := MergeK[*SinglyLinkedList[int]](cmp.Compare[int])
+	if merged == nil || !merged.IsEmpty() {
+		t.Error("expected an empty list")
+	}
+}
+
+func TestMergeKSeq(t *testing.T) {
+	a := SinglyLinkedListOf(1, 3, 5)
+	b := SinglyLinkedListOf(2, 4, 6)
+	got := slices.Collect(MergeKSeq(cmp.Compare[int], a, b))
+	if !slices.Equal(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("expected [1 2 3 4 5 6], got %v", got)
+	}
+	if a.Size() != 3 || b.Size() != 3 {
+		t.Error("expected inputs to be left unchanged")
+	}
+	first := []int{}
+	for v := range MergeKSeq(cmp.Compare[int], a, b) {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+	if !slices.Equal(first, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", first)
+	}
+}
@@ -0,0 +1,242 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+// Receives notifications about the mutations of a list.
+//
+// A list calls its observer synchronously, after the mutation has been
+// applied, from Append, Prepend, InsertAt, the Remove family, Set, the Reverse
+// family and Clear, as well as from the batch and relinking operations built
+// on them. Indexes refer to the list as it is at the time of the call, so
+// replaying the calls in order on a slice reproduces the list. Operations that
+// affect several elements report them one at a time: Clear, for example,
+// reports every element as removed at index 0, from head to tail.
+//
+// Changes made directly through nodes (SetValue, SetNext, ...) or through a
+// View bypass the list and are not reported.
+type Observer[T comparable] interface {
+	// Called after value was inserted at index.
+	OnInsert(index int, value T)
+	// Called after value was removed from index.
+	OnRemove(index int, value T)
+	// Called after the value at index changed from oldValue to newValue.
+	OnSet(index int, oldValue, newValue T)
+	// Called after elements were reordered without being added or removed.
+	OnReorder()
+}
+
+// Registers the observer notified of every mutation of the list, replacing
+// any previous one.
+//
+// Lists without an observer only pay for a nil check.
+//
+// Parameters:
+//   - observer: The observer, or nil to stop observing.
+//
+// Example:
+//
+//	list.SetObserver(metrics)
+func (l *SinglyLinkedList[T]) SetObserver(observer Observer[T]) {
+	l.observer = observer
+}
+
+// Registers the observer notified of every mutation of the list, replacing
+// any previous one.
+//
+// Lists without an observer only pay for a nil check.
+//
+// Parameters:
+//   - observer: The observer, or nil to stop observing.
+//
+// Example:
+//
+//	list.SetObserver(metrics)
+func (l *DoublyLinkedList[T]) SetObserver(observer Observer[T]) {
+	l.observer = observer
+}
+
+// Registers the observer notified of every mutation of the list, replacing
+// any previous one.
+//
+// Lists without an observer only pay for a nil check.
+//
+// Parameters:
+//   - observer: The observer, or nil to stop observing.
+//
+// Example:
+//
+//	list.SetObserver(metrics)
+func (l *CircularSinglyLinkedList[T]) SetObserver(observer Observer[T]) {
+	l.observer = observer
+}
+
+// Registers the observer notified of every mutation of the list, replacing
+// any previous one.
+//
+// Lists without an observer only pay for a nil check.
+//
+// Parameters:
+//   - observer: The observer, or nil to stop observing.
+//
+// Example:
+//
+//	list.SetObserver(metrics)
+func (l *CircularDoublyLinkedList[T]) SetObserver(observer Observer[T]) {
+	l.observer = observer
+}
+
+// Removes the first occurrence of value by index, so that the observer can be
+// told where it was.
+func (l *SinglyLinkedList[T]) removeObserved(value T) bool {
+	index := l.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	l.RemoveAt(index)
+	return true
+}
+
+// Removes the first occurrence of value by index, so that the observer can be
+// told where it was.
+func (l *DoublyLinkedList[T]) removeObserved(value T) bool {
+	index := l.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	l.RemoveAt(index)
+	return true
+}
+
+// Removes the first occurrence of value by index, so that the observer can be
+// told where it was.
+func (l *CircularSinglyLinkedList[T]) removeObserved(value T) bool {
+	index := l.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	l.RemoveAt(index)
+	return true
+}
+
+// Removes the first occurrence of value by index, so that the observer can be
+// told where it was.
+func (l *CircularDoublyLinkedList[T]) removeObserved(value T) bool {
+	index := l.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	l.RemoveAt(index)
+	return true
+}
+
+// Reports values as inserted at consecutive indexes starting at index.
+func notifyInserted[T comparable](observer Observer[T], index int, values []T) {
+	for i, value := range values {
+		observer.OnInsert(index+i, value)
+	}
+}
+
+// Reports the count values of the chain starting at first as removed, one
+// after the other, from index.
+func notifySinglyRemoved[T comparable](observer Observer[T], index int, first *SinglyLinkedNode[T], count int) {
+	for range count {
+		observer.OnRemove(index, first.Value())
+		first = first.Next()
+	}
+}
+
+// Reports the count values of the chain starting at first as removed, one
+// after the other, from index.
+func notifyDoublyRemoved[T comparable](observer Observer[T], index int, first *DoublyLinkedNode[T], count int) {
+	for range count {
+		observer.OnRemove(index, first.Value())
+		first = first.Next()
+	}
+}
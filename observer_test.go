@@ -0,0 +1,167 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+// Replays the notifications it receives on a slice, checking that the
+// reported indexes and old values match.
+type mirrorObserver struct {
+	t        *testing.T
+	values   []int
+	reorders int
+	snapshot func() []int
+}
+
+func (m *mirrorObserver) OnInsert(index int, value int) {
+	m.values = slices.Insert(m.values, index, value)
+}
+
+func (m *mirrorObserver) OnRemove(index int, value int) {
+	if m.values[index] != value {
+		m.t.Errorf("OnRemove(%d, %d): mirror holds %d there", index, value, m.values[index])
+	}
+	m.values = slices.Delete(m.values, index, index+1)
+}
+
+func (m *mirrorObserver) OnSet(index int, oldValue, newValue int) {
+	if m.values[index] != oldValue {
+		m.t.Errorf("OnSet(%d, %d, %d): mirror holds %d there", index, oldValue, newValue, m.values[index])
+	}
+	m.values[index] = newValue
+}
+
+func (m *mirrorObserver) OnReorder() {
+	m.reorders++
+	m.values = m.snapshot()
+}
+
+// The mutators shared by all four list types.
+type observableList interface {
+	SetObserver(Observer[int])
+	ToSlice() []int
+	Append(int)
+	Prepend(int)
+	AppendAll(...int)
+	PrependAll(...int)
+	InsertAt(int, int) error
+	InsertAllAt(int, ...int) error
+	RemoveFirst() bool
+	RemoveLast() bool
+	Remove(int) bool
+	RemoveAt(int) (int, error)
+	RemoveAll(int) int
+	RemoveIf(func(int) bool) int
+	RemoveRange(int, int) error
+	Unique() int
+	Set(int, int) error
+	Reverse()
+	ReverseRange(int, int) error
+	ReverseGroups(int)
+	Clear()
+}
+
+func TestObserverMirrorsMutations(t *testing.T) {
+	lists := map[string]observableList{
+		"SinglyLinkedList":         NewSinglyLinkedList[int](),
+		"DoublyLinkedList":         NewDoublyLinkedList[int](),
+		"CircularSinglyLinkedList": NewCircularSinglyLinkedList[int](),
+		"CircularDoublyLinkedList": NewCircularDoublyLinkedList[int](),
+	}
+	for name, list := range lists {
+		t.Run(name, func(t *testing.T) {
+			mirror := &mirrorObserver{t: t, snapshot: list.ToSlice}
+			list.SetObserver(mirror)
+			steps := []func(){
+				func() { list.Append(1) },
+				func() { list.Prepend(0) },
+				func() { list.AppendAll(5, 6, 7) },
+				func() { list.PrependAll(-2, -1) },
+				func() { list.InsertAt(3, 2) },
+				func() { list.InsertAllAt(5, 3, 4) },
+				func() { list.InsertAt(len(list.ToSlice()), 9) },
+				func() { list.RemoveFirst() },
+				func() { list.RemoveLast() },
+				func() { list.Remove(3) },
+				func() { list.RemoveAt(2) },
+				func() { list.Set(1, 3) },
+				func() { list.AppendAll(3, 3, 8) },
+				func() { list.RemoveAll(3) },
+				func() { list.RemoveIf(func(v int) bool { return v%2 == 0 }) },
+				func() { list.AppendAll(10, 11, 12, 13, 11) },
+				func() { list.Unique() },
+				func() { list.Reverse() },
+				func() { list.ReverseRange(1, 3) },
+				func() { list.ReverseGroups(2) },
+				func() { list.RemoveRange(1, 3) },
+				func() { list.Clear() },
+			}
+			for i, step := range steps {
+				step()
+				if !slices.Equal(mirror.values, list.ToSlice()) {
+					t.Fatalf("step %d: mirror %v does not match list %v", i, mirror.values, list.ToSlice())
+				}
+			}
+			if mirror.reorders != 3 {
+				t.Errorf("expected 3 reorders, got %d", mirror.reorders)
+			}
+
+			list.SetObserver(nil)
+			list.Append(1)
+			if len(mirror.values) != 0 {
+				t.Errorf("expected a removed observer to receive nothing, got %v", mirror.values)
+			}
+		})
+	}
+}
+
+func TestObserverRemoveRangeOfWholeCircularList(t *testing.T) {
+	list := CircularSinglyLinkedListOf(1, 2, 3)
+	mirror := &mirrorObserver{t: t, values: list.ToSlice(), snapshot: list.ToSlice}
+	list.SetObserver(mirror)
+	list.RemoveRange(0, 3)
+	if len(mirror.values) != 0 {
+		t.Errorf("expected the mirror to be empty, got %v", mirror.values)
+	}
+}
+
+func TestObserverRelinkingOperations(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3, 4)
+	mirror := &mirrorObserver{t: t, values: list.ToSlice(), snapshot: list.ToSlice}
+	list.SetObserver(mirror)
+	list.Partition(func(v int) bool { return v%2 == 0 })
+	if len(mirror.values) != 0 {
+		t.Errorf("expected Partition to report every removal, got %v", mirror.values)
+	}
+
+	for name, convert := range map[string]func() []int{
+		"CircularSinglyLinkedList": func() []int {
+			ring := CircularSinglyLinkedListOf(1, 2, 3)
+			mirror = &mirrorObserver{t: t, values: ring.ToSlice(), snapshot: ring.ToSlice}
+			ring.SetObserver(mirror)
+			return ring.ToSinglyLinkedList().ToSlice()
+		},
+		"CircularDoublyLinkedList": func() []int {
+			ring := CircularDoublyLinkedListOf(1, 2, 3)
+			mirror = &mirrorObserver{t: t, values: ring.ToSlice(), snapshot: ring.ToSlice}
+			ring.SetObserver(mirror)
+			return ring.ToDoublyLinkedList().ToSlice()
+		},
+	} {
+		if got := convert(); !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("%s: expected the converted list to hold [1 2 3], got %v", name, got)
+		}
+		if len(mirror.values) != 0 {
+			t.Errorf("%s: expected a relinking conversion to report every removal, got %v", name, mirror.values)
+		}
+	}
+
+	circular := CircularDoublyLinkedListOf(1, 2, 3)
+	mirror = &mirrorObserver{t: t, values: circular.ToSlice(), snapshot: circular.ToSlice}
+	circular.SetObserver(mirror)
+	circular.ToSinglyLinkedList()
+	if !slices.Equal(mirror.values, []int{1, 2, 3}) {
+		t.Errorf("expected a copying conversion to report nothing, got %v", mirror.values)
+	}
+}
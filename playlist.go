@@ -0,0 +1,518 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// Decides what a Playlist does at the ends of the playlist.
+type RepeatMode int
+
+const (
+	// Treats the playlist as linear: Next stops after the last track and
+	// Previous before the first.
+	RepeatOff RepeatMode = iota
+	// Keeps playing the current track.
+	RepeatOne
+	// Treats the playlist as circular: Next wraps from the last track to the
+	// first and Previous the other way around.
+	RepeatAll
+)
+
+// A playlist on a CircularDoublyLinkedList with a current track, repeat
+// modes, seedable shuffle, queue-next insertion and track moves.
+//
+// In shuffle mode every track is played once per round in a random order
+// drawn from the seed. The tracks played so far are kept as a history, so
+// Previous walks back through what was actually played and Next then replays
+// it in the same order.
+//
+// A Playlist is not safe for concurrent use.
+//
+// Example:
+//
+//	playlist := list.NewPlaylist("intro", "verse", "outro")
+//	playlist.SetRepeat(list.RepeatAll)
+//	playlist.Shuffle(42)
+//	track, _ := playlist.Next()
+type Playlist[T comparable] struct {
+	tracks  *CircularDoublyLinkedList[T]
+	current *DoublyLinkedNode[T]
+	repeat  RepeatMode
+	// Shuffle state, all nil when shuffle is off. order holds the history
+	// followed by the rest of the round, and cursor is the entry of the
+	// current track.
+	rng    *rand.Rand
+	order  *DoublyLinkedList[*DoublyLinkedNode[T]]
+	cursor *DoublyLinkedNode[*DoublyLinkedNode[T]]
+}
+
+// Creates a playlist holding the given tracks, with the first one current
+// and repeat and shuffle turned off.
+//
+// Parameters:
+//   - tracks: The tracks, in playlist order.
+//
+// Returns:
+//   - *Playlist[T]: Pointer to the new playlist.
+//
+// Example:
+//
+//	playlist := list.NewPlaylist("intro", "verse", "outro")
+func NewPlaylist[T comparable](tracks ...T) *Playlist[T] {
+	p := &Playlist[T]{tracks: CircularDoublyLinkedListOf(tracks...)}
+	p.current = p.tracks.Head()
+	return p
+}
+
+// Returns the current track.
+//
+// Returns:
+//   - T: The current track, or the zero value if the playlist is empty.
+//   - bool: false if the playlist is empty.
+//
+// Example:
+//
+//	track, ok := playlist.Current()
+func (p *Playlist[T]) Current() (T, bool) {
+	if p.current == nil {
+		var zero T
+		return zero, false
+	}
+	return p.current.Value(), true
+}
+
+// Moves to the track that follows the current one and returns it.
+//
+// With RepeatOne the current track is returned again. In shuffle mode the
+// next track of the round is played; with RepeatAll a new round starts once
+// every track has been played, and never with the track that ended the
+// previous one.
+//
+// Returns:
+//   - T: The new current track, or the zero value if there is none.
+//   - bool: false if the playlist is empty, or if RepeatOff is set and the
+//     last track is playing. The current track is unchanged in that case.
+//
+// Example:
+//
+//	for track, ok := playlist.Current(); ok; track, ok = playlist.Next() {
+//	    play(track)
+//	}
+func (p *Playlist[T]) Next() (T, bool) {
+	var zero T
+	switch {
+	case p.current == nil:
+		return zero, false
+	case p.repeat == RepeatOne:
+	case p.order != nil:
+		if p.cursor.Next() == nil {
+			if p.repeat == RepeatOff {
+				return zero, false
+			}
+			p.appendRound(nil)
+		}
+		p.cursor = p.cursor.Next()
+		p.current = p.cursor.Value()
+	case p.repeat == RepeatOff && p.current == p.tracks.Tail():
+		return zero, false
+	default:
+		p.current = p.current.Next()
+	}
+	return p.current.Value(), true
+}
+
+// Moves to the track that precedes the current one and returns it.
+//
+// With RepeatOne the current track is returned again. In shuffle mode this
+// steps back through the play history.
+//
+// Returns:
+//   - T: The new current track, or the zero value if there is none.
+//   - bool: false if the playlist is empty, if RepeatOff is set and the first
+//     track is playing, or if the start of the shuffle history is reached.
+//     The current track is unchanged in that case.
+//
+// Example:
+//
+//	track, ok := playlist.Previous()
+func (p *Playlist[T]) Previous() (T, bool) {
+	var zero T
+	switch {
+	case p.current == nil:
+		return zero, false
+	case p.repeat == RepeatOne:
+	case p.order != nil:
+		if p.cursor.Prev() == nil {
+			return zero, false
+		}
+		p.cursor = p.cursor.Prev()
+		p.current = p.cursor.Value()
+	case p.repeat == RepeatOff && p.current == p.tracks.Head():
+		return zero, false
+	default:
+		p.current = p.current.Prev()
+	}
+	return p.current.Value(), true
+}
+
+// Returns the repeat mode.
+//
+// Returns:
+//   - RepeatMode: The current mode.
+func (p *Playlist[T]) Repeat() RepeatMode {
+	return p.repeat
+}
+
+// Sets the repeat mode. The current track and the shuffle history are kept.
+//
+// Parameters:
+//   - mode: The new mode.
+//
+// Example:
+//
+//	playlist.SetRepeat(list.RepeatAll)
+func (p *Playlist[T]) SetRepeat(mode RepeatMode) {
+	p.repeat = mode
+}
+
+// Turns shuffle on, starting a new round with the current track followed by
+// the other tracks in a random order. Any previous shuffle history is
+// discarded.
+//
+// The same seed and the same sequence of calls always produce the same order.
+//
+// Parameters:
+//   - seed: Seeds the random order.
+//
+// Example:
+//
+//	playlist.Shuffle(uint64(time.Now().UnixNano()))
+func (p *Playlist[T]) Shuffle(seed uint64) {
+	p.rng = rand.New(rand.NewPCG(seed, seed))
+	p.order = NewDoublyLinkedList[*DoublyLinkedNode[T]]()
+	p.cursor = nil
+	if p.current != nil {
+		p.order.Append(p.current)
+		p.cursor = p.order.Head()
+		p.appendRound(p.current)
+	}
+}
+
+// Turns shuffle off and drops the history. Playback continues in playlist
+// order from the current track.
+func (p *Playlist[T]) Unshuffle() {
+	p.rng, p.order, p.cursor = nil, nil, nil
+}
+
+// Reports whether shuffle is on.
+//
+// Returns:
+//   - bool: true in shuffle mode.
+func (p *Playlist[T]) Shuffled() bool {
+	return p.order != nil
+}
+
+// Adds a track at the end of the playlist. In shuffle mode it is also placed
+// at a random position among the tracks still to be played in the round.
+//
+// Parameters:
+//   - track: The track to add.
+//
+// Example:
+//
+//	playlist.Append("encore")
+func (p *Playlist[T]) Append(track T) {
+	node := NewDoublyLinkedNode(track)
+	p.tracks.linkAfter(p.tracks.Tail(), node, node, 1)
+	p.tracks.tail = node
+	if p.current == nil {
+		p.start(node)
+		return
+	}
+	if p.order != nil {
+		upcoming := 0
+		for entry := p.cursor.Next(); entry != nil; entry = entry.Next() {
+			upcoming++
+		}
+		prev := p.cursor
+		for range p.rng.IntN(upcoming + 1) {
+			prev = prev.Next()
+		}
+		entry := NewDoublyLinkedNode(node)
+		p.order.linkAfter(prev, entry, entry, 1)
+	}
+}
+
+// Inserts a track right after the current one, so that it is the next to
+// play, in shuffle mode as well.
+//
+// Parameters:
+//   - track: The track to queue.
+//
+// Example:
+//
+//	playlist.QueueNext("request")
+func (p *Playlist[T]) QueueNext(track T) {
+	if p.current == nil {
+		p.Append(track)
+		return
+	}
+	node := NewDoublyLinkedNode(track)
+	p.tracks.linkAfter(p.current, node, node, 1)
+	if p.current == p.tracks.Tail() {
+		p.tracks.tail = node
+	}
+	if p.order != nil {
+		entry := NewDoublyLinkedNode(node)
+		p.order.linkAfter(p.cursor, entry, entry, 1)
+	}
+}
+
+// Moves the track at index from so that it ends up at index to, by relinking
+// its node. The current track and the shuffle order are unchanged.
+//
+// Parameters:
+//   - from: Index of the track to move (0-based, in playlist order).
+//   - to: Index of the track after the move.
+//
+// Returns:
+//   - error: An *IndexError if either index is out of bounds.
+//
+// Example:
+//
+//	err := playlist.Move(4, 0) // the fifth track becomes the first
+func (p *Playlist[T]) Move(from, to int) error {
+	size := p.tracks.Size()
+	if from < 0 || from >= size {
+		return newIndexError("Move", from, size)
+	}
+	if to < 0 || to >= size {
+		return newIndexError("Move", to, size)
+	}
+	if from == to {
+		return nil
+	}
+	node := p.tracks.nodeAt(from)
+	p.tracks.unlink(node)
+	// Linking after the tail makes the node the new head.
+	prev := p.tracks.Tail()
+	if to > 0 {
+		prev = p.tracks.nodeAt(to - 1)
+	}
+	p.tracks.linkAfter(prev, node, node, 1)
+	if to == size-1 {
+		p.tracks.tail = node
+	}
+	return nil
+}
+
+// Removes the first track equal to the given value, along with its entries
+// in the shuffle history.
+//
+// If it was the current track, the track that follows it becomes current:
+// the next one in playlist order, wrapping around, or in shuffle mode the
+// next one in the round, falling back to the history.
+//
+// Parameters:
+//   - track: The track to remove.
+//
+// Returns:
+//   - bool: true if a track was removed, false if it was not found.
+//
+// Example:
+//
+//	playlist.Remove("skit")
+func (p *Playlist[T]) Remove(track T) bool {
+	node := p.tracks.Find(track)
+	if node == nil {
+		return false
+	}
+	if p.order != nil {
+		if p.cursor.Value() == node {
+			entry := p.cursor.Next()
+			for entry != nil && entry.Value() == node {
+				entry = entry.Next()
+			}
+			if entry == nil {
+				entry = p.cursor.Prev()
+				for entry != nil && entry.Value() == node {
+					entry = entry.Prev()
+				}
+			}
+			p.cursor = entry
+		}
+		p.order.RemoveIf(func(n *DoublyLinkedNode[T]) bool { return n == node })
+	}
+	if node == p.current {
+		p.current = node.Next()
+		if p.cursor != nil {
+			p.current = p.cursor.Value()
+		}
+	}
+	p.tracks.unlink(node)
+	if p.tracks.IsEmpty() {
+		p.current, p.cursor = nil, nil
+	}
+	return true
+}
+
+// Returns the number of tracks.
+//
+// Returns:
+//   - int: The number of tracks.
+func (p *Playlist[T]) Len() int {
+	return p.tracks.Size()
+}
+
+// Returns an iterator over the tracks in playlist order, regardless of
+// shuffle.
+//
+// Returns:
+//   - iter.Seq[T]: The tracks, from the first to the last.
+//
+// Example:
+//
+//	for track := range playlist.All() {
+//	    fmt.Println(track)
+//	}
+func (p *Playlist[T]) All() iter.Seq[T] {
+	return p.tracks.All()
+}
+
+// Returns the tracks in playlist order as a slice.
+//
+// Returns:
+//   - []T: A new slice holding the tracks.
+func (p *Playlist[T]) ToSlice() []T {
+	return p.tracks.ToSlice()
+}
+
+// Makes node the current track of a playlist that was empty.
+func (p *Playlist[T]) start(node *DoublyLinkedNode[T]) {
+	p.current = node
+	if p.order != nil {
+		p.order.Append(node)
+		p.cursor = p.order.Head()
+	}
+}
+
+// Appends every track except skip to the shuffle order, in a random order.
+//
+// A nil skip starts a new round after the current one; it then avoids
+// playing the current track twice in a row, and drops the history of the
+// rounds before the one that just ended, so it stays within two rounds.
+func (p *Playlist[T]) appendRound(skip *DoublyLinkedNode[T]) {
+	nodes := make([]*DoublyLinkedNode[T], 0, p.tracks.Size())
+	node := p.tracks.Head()
+	for range p.tracks.Size() {
+		if node != skip {
+			nodes = append(nodes, node)
+		}
+		node = node.Next()
+	}
+	p.rng.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+	if skip == nil {
+		if len(nodes) > 1 && nodes[0] == p.current {
+			j := 1 + p.rng.IntN(len(nodes)-1)
+			nodes[0], nodes[j] = nodes[j], nodes[0]
+		}
+		for p.order.Head() != p.cursor && p.order.Size() > len(nodes) {
+			p.order.RemoveFirst()
+		}
+	}
+	for _, node := range nodes {
+		p.order.Append(node)
+	}
+}
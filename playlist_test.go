@@ -0,0 +1,231 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Calls next n times and collects the returned tracks, failing on the first
+// call that reports no track.
+func playNext(t *testing.T, next func() (string, bool), n int) []string {
+	t.Helper()
+	played := make([]string, 0, n)
+	for range n {
+		track, ok := next()
+		if !ok {
+			t.Fatalf("expected %d tracks, stopped after %v", n, played)
+		}
+		played = append(played, track)
+	}
+	return played
+}
+
+func TestPlaylistRepeatModes(t *testing.T) {
+	playlist := NewPlaylist("a", "b", "c")
+	if track, ok := playlist.Current(); !ok || track != "a" {
+		t.Fatalf("expected a to be current, got %q", track)
+	}
+	if played := playNext(t, playlist.Next, 2); !slices.Equal(played, []string{"b", "c"}) {
+		t.Errorf("expected [b c], got %v", played)
+	}
+	if _, ok := playlist.Next(); ok {
+		t.Error("expected Next to stop at the last track without repeat")
+	}
+	if track, _ := playlist.Current(); track != "c" {
+		t.Errorf("expected c to stay current, got %q", track)
+	}
+
+	playlist.SetRepeat(RepeatAll)
+	if played := playNext(t, playlist.Next, 2); !slices.Equal(played, []string{"a", "b"}) {
+		t.Errorf("expected Next to wrap around to [a b], got %v", played)
+	}
+	if played := playNext(t, playlist.Previous, 2); !slices.Equal(played, []string{"a", "c"}) {
+		t.Errorf("expected Previous to wrap around to [a c], got %v", played)
+	}
+
+	playlist.SetRepeat(RepeatOne)
+	if played := playNext(t, playlist.Next, 2); !slices.Equal(played, []string{"c", "c"}) {
+		t.Errorf("expected the current track to repeat, got %v", played)
+	}
+
+	playlist.SetRepeat(RepeatOff)
+	playNext(t, playlist.Previous, 2)
+	if _, ok := playlist.Previous(); ok {
+		t.Error("expected Previous to stop at the first track without repeat")
+	}
+}
+
+func TestPlaylistEmpty(t *testing.T) {
+	playlist := NewPlaylist[string]()
+	playlist.Shuffle(1)
+	if _, ok := playlist.Next(); ok {
+		t.Error("expected Next to fail on an empty playlist")
+	}
+	if _, ok := playlist.Previous(); ok {
+		t.Error("expected Previous to fail on an empty playlist")
+	}
+	playlist.Append("a")
+	if track, ok := playlist.Current(); !ok || track != "a" {
+		t.Errorf("expected the first track added to become current, got %q", track)
+	}
+}
+
+func TestPlaylistShuffle(t *testing.T) {
+	tracks := []string{"a", "b", "c", "d", "e", "f"}
+	first := NewPlaylist(tracks...)
+	first.Shuffle(7)
+	round := append([]string{"a"}, playNext(t, first.Next, 5)...)
+	if sorted := slices.Sorted(slices.Values(round)); !slices.Equal(sorted, tracks) {
+		t.Fatalf("expected every track once per round, got %v", round)
+	}
+	if _, ok := first.Next(); ok {
+		t.Error("expected shuffle without repeat to stop after one round")
+	}
+
+	second := NewPlaylist(tracks...)
+	second.Shuffle(7)
+	if again := append([]string{"a"}, playNext(t, second.Next, 5)...); !slices.Equal(again, round) {
+		t.Errorf("expected the same seed to give %v, got %v", round, again)
+	}
+
+	back := playNext(t, first.Previous, 5)
+	slices.Reverse(back)
+	if !slices.Equal(back, round[:5]) {
+		t.Errorf("expected Previous to retrace %v, got %v", round[:5], back)
+	}
+	if _, ok := first.Previous(); ok {
+		t.Error("expected Previous to stop at the start of the history")
+	}
+	if replay := playNext(t, first.Next, 5); !slices.Equal(replay, round[1:]) {
+		t.Errorf("expected Next to replay %v, got %v", round[1:], replay)
+	}
+}
+
+func TestPlaylistShuffleRepeatAll(t *testing.T) {
+	playlist := NewPlaylist("a", "b", "c", "d")
+	playlist.SetRepeat(RepeatAll)
+	playlist.Shuffle(3)
+	played := append([]string{"a"}, playNext(t, playlist.Next, 15)...)
+	for i := 0; i < len(played); i += 4 {
+		round := slices.Sorted(slices.Values(played[i : i+4]))
+		if !slices.Equal(round, []string{"a", "b", "c", "d"}) {
+			t.Errorf("expected round %d to play every track once, got %v", i/4, played[i:i+4])
+		}
+	}
+	for i := 1; i < len(played); i++ {
+		if played[i] == played[i-1] {
+			t.Errorf("expected no track to play twice in a row, got %v", played)
+		}
+	}
+	if playlist.order.Size() > 8 {
+		t.Errorf("expected the history to stay within two rounds, got %d entries", playlist.order.Size())
+	}
+	back := playNext(t, playlist.Previous, 4)
+	if !slices.Equal(back, []string{played[14], played[13], played[12], played[11]}) {
+		t.Errorf("expected Previous to cross back into the previous round, got %v", back)
+	}
+}
+
+func TestPlaylistQueueNext(t *testing.T) {
+	playlist := NewPlaylist("a", "b", "c")
+	playlist.QueueNext("x")
+	if track, _ := playlist.Next(); track != "x" {
+		t.Errorf("expected the queued track next, got %q", track)
+	}
+	if !slices.Equal(playlist.ToSlice(), []string{"a", "x", "b", "c"}) {
+		t.Errorf("expected x after a, got %v", playlist.ToSlice())
+	}
+
+	playlist.Shuffle(11)
+	playlist.QueueNext("y")
+	if track, _ := playlist.Next(); track != "y" {
+		t.Errorf("expected the queued track next in shuffle mode, got %q", track)
+	}
+	playlist.Append("z")
+	rest := playNext(t, playlist.Next, 4)
+	if !slices.Contains(rest, "z") {
+		t.Errorf("expected the appended track in the rest of the round, got %v", rest)
+	}
+}
+
+func TestPlaylistMove(t *testing.T) {
+	playlist := NewPlaylist("a", "b", "c", "d")
+	playlist.Next()
+	tests := []struct {
+		from, to int
+		want     []string
+	}{
+		{3, 0, []string{"d", "a", "b", "c"}},
+		{0, 3, []string{"a", "b", "c", "d"}},
+		{1, 2, []string{"a", "c", "b", "d"}},
+		{2, 2, []string{"a", "c", "b", "d"}},
+	}
+	for _, test := range tests {
+		if err := playlist.Move(test.from, test.to); err != nil {
+			t.Fatalf("Move(%d, %d): unexpected error: %v", test.from, test.to, err)
+		}
+		if !slices.Equal(playlist.ToSlice(), test.want) {
+			t.Errorf("Move(%d, %d): expected %v, got %v", test.from, test.to, test.want, playlist.ToSlice())
+		}
+	}
+	if track, _ := playlist.Current(); track != "b" {
+		t.Errorf("expected b to stay current, got %q", track)
+	}
+	if track, _ := playlist.Next(); track != "d" {
+		t.Errorf("expected playback to follow the new order, got %q", track)
+	}
+	if err := playlist.Move(0, 4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestPlaylistRemove(t *testing.T) {
+	playlist := NewPlaylist("a", "b", "c")
+	playlist.Next()
+	if !playlist.Remove("b") {
+		t.Fatal("expected b to be removed")
+	}
+	if track, _ := playlist.Current(); track != "c" {
+		t.Errorf("expected the following track to become current, got %q", track)
+	}
+	if playlist.Remove("x") {
+		t.Error("expected an unknown track not to be removed")
+	}
+
+	playlist = NewPlaylist("a", "b", "c", "d")
+	playlist.Shuffle(5)
+	played := append([]string{"a"}, playNext(t, playlist.Next, 2)...)
+	playlist.Remove(played[1])
+	back, _ := playlist.Previous()
+	if back != "a" {
+		t.Errorf("expected the removed track to leave the history, got %q", back)
+	}
+	playlist.Remove("a")
+	if track, _ := playlist.Current(); track != played[2] {
+		t.Errorf("expected %q to become current, got %q", played[2], track)
+	}
+	for playlist.Len() > 0 {
+		track, _ := playlist.Current()
+		playlist.Remove(track)
+	}
+	if _, ok := playlist.Current(); ok {
+		t.Error("expected no current track once the playlist is empty")
+	}
+}
+
+func TestPlaylistUnshuffle(t *testing.T) {
+	playlist := NewPlaylist("a", "b", "c", "d")
+	playlist.Shuffle(9)
+	playlist.Next()
+	current, _ := playlist.Current()
+	playlist.Unshuffle()
+	if playlist.Shuffled() {
+		t.Error("expected shuffle to be off")
+	}
+	index := slices.Index(playlist.ToSlice(), current)
+	playlist.SetRepeat(RepeatAll)
+	if track, _ := playlist.Next(); track != playlist.ToSlice()[(index+1)%4] {
+		t.Errorf("expected playback to continue in playlist order after %q, got %q", current, track)
+	}
+}
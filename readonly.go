@@ -0,0 +1,479 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"fmt"
+	"io"
+	"iter"
+)
+
+// A read-only wrapper around any of the four list types.
+//
+// It exposes size, lookup, iteration and copies, and hands out NodeView values
+// instead of the underlying nodes, so consumers cannot relink or modify the
+// wrapped list. The wrapper is live: changes made to the list through the
+// original reference are visible through it.
+type ReadOnly[T comparable] struct {
+	source readOnlySource[T]
+}
+
+// The subset of list behaviour a ReadOnly wrapper relies on.
+type readOnlySource[T comparable] interface {
+	Size() int
+	IndexOf(value T) int
+	layout() listLayout[T]
+	values() iter.Seq[T]
+}
+
+// The node accessors of SinglyLinkedList and CircularSinglyLinkedList.
+type singlyNodeSource[T comparable] interface {
+	Head() *SinglyLinkedNode[T]
+	Tail() *SinglyLinkedNode[T]
+	nodeAt(index int) *SinglyLinkedNode[T]
+}
+
+// The node accessors of DoublyLinkedList and CircularDoublyLinkedList.
+type doublyNodeSource[T comparable] interface {
+	Head() *DoublyLinkedNode[T]
+	Tail() *DoublyLinkedNode[T]
+	nodeAt(index int) *DoublyLinkedNode[T]
+}
+
+// An immutable view of a list node: its value and its neighbours, without any
+// setters.
+//
+// Navigation follows the underlying links, so on circular lists Next and Prev
+// wrap around.
+type NodeView[T comparable] struct {
+	singly *SinglyLinkedNode[T]
+	doubly *DoublyLinkedNode[T]
+}
+
+// Wraps a singly linked node, returning nil for a nil node.
+func singlyNodeView[T comparable](node *SinglyLinkedNode[T]) *NodeView[T] {
+	if node == nil {
+		return nil
+	}
+	return &NodeView[T]{singly: node}
+}
+
+// Wraps a doubly linked node, returning nil for a nil node.
+func doublyNodeView[T comparable](node *DoublyLinkedNode[T]) *NodeView[T] {
+	if node == nil {
+		return nil
+	}
+	return &NodeView[T]{doubly: node}
+}
+
+// Returns the value stored in the node.
+//
+// Returns:
+//   - T: The value of the node.
+//
+// Example:
+//
+//	fmt.Println(view.Head().Value())
+func (n *NodeView[T]) Value() T {
+	if n.singly != nil {
+		return n.singly.Value()
+	}
+	return n.doubly.Value()
+}
+
+// Returns a view of the next node.
+//
+// Returns:
+//   - *NodeView[T]: The next node, or nil at the end of a linear list.
+//
+// Example:
+//
+//	for n := view.Head(); n != nil; n = n.Next() {
+//	    fmt.Println(n.Value())
+//	}
+func (n *NodeView[T]) Next() *NodeView[T] {
+	if n.singly != nil {
+		return singlyNodeView(n.singly.Next())
+	}
+	return doublyNodeView(n.doubly.Next())
+}
+
+// Returns a view of the previous node.
+//
+// Returns:
+//   - *NodeView[T]: The previous node, or nil at the start of a linear list
+//     and always nil for singly linked nodes.
+//
+// Example:
+//
+//	for n := view.Tail(); n != nil; n = n.Prev() {
+//	    fmt.Println(n.Value())
+//	}
+func (n *NodeView[T]) Prev() *NodeView[T] {
+	if n.singly != nil {
+		return nil
+	}
+	return doublyNodeView(n.doubly.Prev())
+}
+
+// Returns a read-only wrapper around the list.
+//
+// Returns:
+//   - *ReadOnly[T]: A live, read-only view of the list.
+//
+// Example:
+//
+//	func (s *Service) Items() *list.ReadOnly[int] {
+//	    return s.items.ReadOnly()
+//	}
+func (l *SinglyLinkedList[T]) ReadOnly() *ReadOnly[T] {
+	return &ReadOnly[T]{source: l}
+}
+
+// Returns a read-only wrapper around the list.
+//
+// Returns:
+//   - *ReadOnly[T]: A live, read-only view of the list.
+//
+// Example:
+//
+//	func (s *Service) Items() *list.ReadOnly[int] {
+//	    return s.items.ReadOnly()
+//	}
+func (l *DoublyLinkedList[T]) ReadOnly() *ReadOnly[T] {
+	return &ReadOnly[T]{source: l}
+}
+
+// Returns a read-only wrapper around the list.
+//
+// Returns:
+//   - *ReadOnly[T]: A live, read-only view of the list.
+//
+// Example:
+//
+//	func (s *Service) Items() *list.ReadOnly[int] {
+//	    return s.items.ReadOnly()
+//	}
+func (l *CircularSinglyLinkedList[T]) ReadOnly() *ReadOnly[T] {
+	return &ReadOnly[T]{source: l}
+}
+
+// Returns a read-only wrapper around the list.
+//
+// Returns:
+//   - *ReadOnly[T]: A live, read-only view of the list.
+//
+// Example:
+//
+//	func (s *Service) Items() *list.ReadOnly[int] {
+//	    return s.items.ReadOnly()
+//	}
+func (l *CircularDoublyLinkedList[T]) ReadOnly() *ReadOnly[T] {
+	return &ReadOnly[T]{source: l}
+}
+
+// Returns the number of elements in the wrapped list.
+//
+// Returns:
+//   - int: Number of elements.
+//
+// Example:
+//
+//	fmt.Println(view.Size())
+func (r *ReadOnly[T]) Size() int {
+	return r.source.Size()
+}
+
+// Checks whether the wrapped list is empty.
+//
+// Returns:
+//   - bool: True if the list has no elements.
+//
+// Example:
+//
+//	if view.IsEmpty() {
+//	    return
+//	}
+func (r *ReadOnly[T]) IsEmpty() bool {
+	return r.source.Size() == 0
+}
+
+// Returns a view of the first node.
+//
+// Returns:
+//   - *NodeView[T]: The head, or nil if the list is empty.
+//
+// Example:
+//
+//	head := view.Head()
+func (r *ReadOnly[T]) Head() *NodeView[T] {
+	if source, ok := r.source.(singlyNodeSource[T]); ok {
+		return singlyNodeView(source.Head())
+	}
+	return doublyNodeView(r.source.(doublyNodeSource[T]).Head())
+}
+
+// Returns a view of the last node.
+//
+// Returns:
+//   - *NodeView[T]: The tail, or nil if the list is empty.
+//
+// Example:
+//
+//	tail := view.Tail()
+func (r *ReadOnly[T]) Tail() *NodeView[T] {
+	if source, ok := r.source.(singlyNodeSource[T]); ok {
+		return singlyNodeView(source.Tail())
+	}
+	return doublyNodeView(r.source.(doublyNodeSource[T]).Tail())
+}
+
+// Retrieves a view of the node at the specified index.
+//
+// Parameters:
+//   - index: Position of the node (0-based).
+//
+// Returns:
+//   - *NodeView[T]: The node at the given index.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	node, err := view.Get(2)
+func (r *ReadOnly[T]) Get(index int) (*NodeView[T], error) {
+	if index < 0 || index >= r.source.Size() {
+		return nil, newIndexError("Get", index, r.source.Size())
+	}
+	if source, ok := r.source.(singlyNodeSource[T]); ok {
+		return singlyNodeView(source.nodeAt(index)), nil
+	}
+	return doublyNodeView(r.source.(doublyNodeSource[T]).nodeAt(index)), nil
+}
+
+// Searches for the first node with the given value.
+//
+// Parameters:
+//   - value: The value to find.
+//
+// Returns:
+//   - *NodeView[T]: The first matching node, or nil if not found.
+//
+// Example:
+//
+//	if node := view.Find(3); node != nil {
+//	    fmt.Println(node.Value())
+//	}
+func (r *ReadOnly[T]) Find(value T) *NodeView[T] {
+	current := r.Head()
+	for range r.source.Size() {
+		if current.Value() == value {
+			return current
+		}
+		current = current.Next()
+	}
+	return nil
+}
+
+// Returns the index of the first element equal to value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The index, or -1 if the value is not present.
+//
+// Example:
+//
+//	index := view.IndexOf(3)
+func (r *ReadOnly[T]) IndexOf(value T) int {
+	return r.source.IndexOf(value)
+}
+
+// Checks whether the wrapped list contains the value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: True if the value is present.
+//
+// Example:
+//
+//	if view.Contains(3) {
+//	    fmt.Println("found")
+//	}
+func (r *ReadOnly[T]) Contains(value T) bool {
+	return r.source.IndexOf(value) >= 0
+}
+
+// Returns an iterator over the values of the wrapped list, from head to tail.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range view.All() {
+//	    fmt.Println(v)
+//	}
+func (r *ReadOnly[T]) All() iter.Seq[T] {
+	return r.source.values()
+}
+
+// Returns a slice containing a copy of the elements.
+//
+// Returns:
+//   - []T: Slice of the elements, from head to tail.
+//
+// Example:
+//
+//	values := view.ToSlice()
+func (r *ReadOnly[T]) ToSlice() []T {
+	result := make([]T, 0, r.source.Size())
+	for value := range r.source.values() {
+		result = append(result, value)
+	}
+	return result
+}
+
+// Returns a copy of the elements as a new DoublyLinkedList that the caller
+// is free to modify.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: A new list with the same values.
+//
+// Example:
+//
+//	mine := view.ToDoublyLinkedList()
+//	mine.Append(4)
+func (r *ReadOnly[T]) ToDoublyLinkedList() *DoublyLinkedList[T] {
+	return DoublyLinkedListFromSlice(r.ToSlice())
+}
+
+// Returns the same representation as the wrapped list's String.
+//
+// Returns:
+//   - string: The formatted list.
+//
+// Example:
+//
+//	fmt.Println(view.String())
+func (r *ReadOnly[T]) String() string {
+	return listString(r.source.layout())
+}
+
+// Implements fmt.Formatter with the same verbs and flags as the wrapped list.
+//
+// Parameters:
+//   - f: The formatter state.
+//   - verb: The formatting verb.
+//
+// Example:
+//
+//	fmt.Printf("%+v\n", view)
+func (r *ReadOnly[T]) Format(f fmt.State, verb rune) {
+	formatList(f, verb, r.source.layout())
+}
+
+// Writes the representation of the wrapped list to w.
+//
+// Parameters:
+//   - w: The destination writer.
+//
+// Returns:
+//   - int64: Number of bytes written.
+//   - error: Any error returned by w.
+//
+// Example:
+//
+//	view.WriteTo(os.Stdout)
+func (r *ReadOnly[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeList(w, r.source.layout(), defaultFormatOptions)
+}
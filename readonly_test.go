@@ -0,0 +1,81 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestReadOnlyLinearList(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3)
+	view := list.ReadOnly()
+	if view.Size() != 3 || view.IsEmpty() {
+		t.Fatalf("expected size 3, got %d", view.Size())
+	}
+	if view.Head().Value() != 1 || view.Tail().Value() != 3 {
+		t.Errorf("expected head 1 and tail 3, got %d and %d", view.Head().Value(), view.Tail().Value())
+	}
+	if view.Head().Prev() != nil || view.Tail().Next() != nil {
+		t.Error("expected linear navigation to stop at the ends")
+	}
+	if view.Tail().Prev().Value() != 2 {
+		t.Errorf("expected Prev of tail to be 2, got %d", view.Tail().Prev().Value())
+	}
+	node, err := view.Get(1)
+	if err != nil || node.Value() != 2 {
+		t.Errorf("expected Get(1) to be 2, got %v (%v)", node, err)
+	}
+	if _, err := view.Get(3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+	if view.Find(3).Value() != 3 || view.Find(4) != nil {
+		t.Error("unexpected Find result")
+	}
+	if view.IndexOf(2) != 1 || !view.Contains(3) || view.Contains(4) {
+		t.Error("unexpected lookup result")
+	}
+	if view.String() != list.String() {
+		t.Errorf("expected %q, got %q", list.String(), view.String())
+	}
+
+	list.Append(4)
+	if !slices.Equal(slices.Collect(view.All()), []int{1, 2, 3, 4}) {
+		t.Errorf("expected the wrapper to reflect the list, got %v", view.ToSlice())
+	}
+	copied := view.ToDoublyLinkedList()
+	copied.Append(5)
+	if list.Size() != 4 {
+		t.Errorf("expected the copy to be independent, got size %d", list.Size())
+	}
+}
+
+func TestReadOnlySinglyNodesHaveNoPrev(t *testing.T) {
+	view := SinglyLinkedListOf(1, 2).ReadOnly()
+	if view.Tail().Prev() != nil {
+		t.Error("expected Prev of a singly linked node to be nil")
+	}
+	if view.Head().Next().Value() != 2 {
+		t.Errorf("expected Next of head to be 2, got %d", view.Head().Next().Value())
+	}
+	if empty := NewSinglyLinkedList[int]().ReadOnly(); empty.Head() != nil || empty.Tail() != nil || empty.Find(1) != nil {
+		t.Error("expected an empty wrapper to have no nodes")
+	}
+}
+
+func TestReadOnlyCircularLists(t *testing.T) {
+	singly := CircularSinglyLinkedListOf(1, 2, 3).ReadOnly()
+	if singly.Tail().Next().Value() != 1 {
+		t.Errorf("expected Next of tail to wrap to 1, got %d", singly.Tail().Next().Value())
+	}
+	doubly := CircularDoublyLinkedListOf(1, 2, 3).ReadOnly()
+	if doubly.Head().Prev().Value() != 3 {
+		t.Errorf("expected Prev of head to wrap to 3, got %d", doubly.Head().Prev().Value())
+	}
+	if !slices.Equal(doubly.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", doubly.ToSlice())
+	}
+	if got := fmt.Sprintf("%.2v", doubly); got != fmt.Sprintf("%.2v", CircularDoublyLinkedListOf(1, 2, 3)) {
+		t.Errorf("unexpected formatting %q", got)
+	}
+}
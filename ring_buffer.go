@@ -0,0 +1,344 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "iter"
+
+// A fixed-capacity buffer on a pre-linked CircularDoublyLinkedList, where
+// Push overwrites the oldest element once the buffer is full.
+//
+// All nodes are allocated by NewRingBuffer; pushing, reading and iterating do
+// not allocate.
+type RingBuffer[T comparable] struct {
+	ring *CircularDoublyLinkedList[T]
+	// The most recently written node; its successor is the next to write.
+	newest *DoublyLinkedNode[T]
+	oldest *DoublyLinkedNode[T]
+	size   int
+}
+
+// Creates an empty ring buffer holding at most capacity elements.
+//
+// Parameters:
+//   - capacity: The number of elements kept. Must be positive.
+//
+// Returns:
+//   - *RingBuffer[T]: Pointer to the new buffer.
+//
+// Example:
+//
+//	recent := list.NewRingBuffer[string](100)
+func NewRingBuffer[T comparable](capacity int) *RingBuffer[T] {
+	if capacity < 1 {
+		panic("list: ring buffer capacity must be positive")
+	}
+	ring := NewCircularDoublyLinkedList[T]()
+	ring.AppendAll(make([]T, capacity)...)
+	return &RingBuffer[T]{ring: ring, newest: ring.Tail()}
+}
+
+// Adds a value as the newest element, overwriting the oldest one if the
+// buffer is full.
+//
+// Parameters:
+//   - value: The value to add.
+//
+// Returns:
+//   - T: The overwritten value, if any.
+//   - bool: True if a value was overwritten.
+//
+// Example:
+//
+//	if dropped, ok := recent.Push("event"); ok {
+//	    log.Println("dropped", dropped)
+//	}
+func (r *RingBuffer[T]) Push(value T) (T, bool) {
+	r.newest = r.newest.Next()
+	overwritten, full := r.newest.Value(), r.IsFull()
+	r.newest.SetValue(value)
+	if full {
+		r.oldest = r.newest.Next()
+		return overwritten, true
+	}
+	if r.size == 0 {
+		r.oldest = r.newest
+	}
+	r.size++
+	var zero T
+	return zero, false
+}
+
+// Returns the oldest element.
+//
+// Returns:
+//   - T: The oldest value.
+//   - bool: False if the buffer is empty.
+//
+// Example:
+//
+//	if value, ok := recent.Oldest(); ok {
+//	    fmt.Println(value)
+//	}
+func (r *RingBuffer[T]) Oldest() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.oldest.Value(), true
+}
+
+// Returns the most recently pushed element.
+//
+// Returns:
+//   - T: The newest value.
+//   - bool: False if the buffer is empty.
+//
+// Example:
+//
+//	if value, ok := recent.Newest(); ok {
+//	    fmt.Println(value)
+//	}
+func (r *RingBuffer[T]) Newest() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.newest.Value(), true
+}
+
+// Returns an iterator over the n most recent elements, in chronological
+// order. Fewer elements are yielded if the buffer holds fewer than n.
+//
+// Parameters:
+//   - n: The number of elements.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator from the oldest to the newest of them.
+//
+// Example:
+//
+//	for v := range recent.Latest(10) {
+//	    fmt.Println(v)
+//	}
+func (r *RingBuffer[T]) Latest(n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		r.yieldLatest(n, yield)
+	}
+}
+
+// Returns an iterator over the elements in chronological order.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator from the oldest to the newest element.
+//
+// Example:
+//
+//	for v := range recent.All() {
+//	    fmt.Println(v)
+//	}
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		r.yieldLatest(r.size, yield)
+	}
+}
+
+// Returns an iterator over the elements from the newest to the oldest.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator in reverse chronological order.
+//
+// Example:
+//
+//	for v := range recent.Backward() {
+//	    fmt.Println(v)
+//	}
+func (r *RingBuffer[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := r.newest
+		for range r.size {
+			if !yield(current.Value()) {
+				return
+			}
+			current = current.Prev()
+		}
+	}
+}
+
+// Returns the number of elements in the buffer.
+//
+// Returns:
+//   - int: Number of elements, at most Cap.
+//
+// Example:
+//
+//	fmt.Println(recent.Len())
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Returns the capacity of the buffer.
+//
+// Returns:
+//   - int: The maximum number of elements.
+//
+// Example:
+//
+//	fmt.Println(recent.Cap())
+func (r *RingBuffer[T]) Cap() int {
+	return r.ring.Size()
+}
+
+// Checks whether the next Push will overwrite an element.
+//
+// Returns:
+//   - bool: True if the buffer holds Cap elements.
+//
+// Example:
+//
+//	if recent.IsFull() {
+//	    fmt.Println("overwriting from now on")
+//	}
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.size == r.ring.Size()
+}
+
+// Empties the buffer, releasing references held by its elements. The nodes
+// are kept for reuse.
+//
+// Example:
+//
+//	recent.Clear()
+func (r *RingBuffer[T]) Clear() {
+	var zero T
+	current := r.newest
+	for range r.size {
+		current.SetValue(zero)
+		current = current.Prev()
+	}
+	r.size = 0
+}
+
+// Returns a human-readable representation of the buffer in chronological
+// order.
+//
+// Returns:
+//   - string: The formatted buffer.
+//
+// Example:
+//
+//	fmt.Println(recent) // RingBuffer: [1] <-> [2] <-> [3]
+func (r *RingBuffer[T]) String() string {
+	layout := listLayout[T]{
+		name:      "RingBuffer",
+		separator: " <-> ",
+		size:      r.size,
+		values:    r.All(),
+	}
+	if r.size > 0 {
+		layout.head = r.oldest.Value()
+		layout.tail = r.newest.Value()
+	}
+	return listString(layout)
+}
+
+// Yields the n most recent elements in chronological order.
+func (r *RingBuffer[T]) yieldLatest(n int, yield func(T) bool) {
+	n = max(0, min(n, r.size))
+	current := r.newest
+	for range n - 1 {
+		current = current.Prev()
+	}
+	for range n {
+		if !yield(current.Value()) {
+			return
+		}
+		current = current.Next()
+	}
+}
@@ -0,0 +1,107 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingBufferPushOverwritesOldest(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	if _, ok := r.Oldest(); ok {
+		t.Error("expected an empty buffer to have no oldest element")
+	}
+	for i := 1; i <= 3; i++ {
+		if _, overwritten := r.Push(i); overwritten {
+			t.Fatalf("expected no overwrite while filling, at %d", i)
+		}
+	}
+	if !r.IsFull() || r.Len() != 3 || r.Cap() != 3 {
+		t.Fatalf("expected a full buffer of 3, got len %d cap %d", r.Len(), r.Cap())
+	}
+	if old, overwritten := r.Push(4); !overwritten || old != 1 {
+		t.Errorf("expected 1 to be overwritten, got %d, %v", old, overwritten)
+	}
+	r.Push(5)
+	if !slices.Equal(slices.Collect(r.All()), []int{3, 4, 5}) {
+		t.Errorf("expected [3 4 5], got %v", slices.Collect(r.All()))
+	}
+	if !slices.Equal(slices.Collect(r.Backward()), []int{5, 4, 3}) {
+		t.Errorf("expected [5 4 3], got %v", slices.Collect(r.Backward()))
+	}
+	if oldest, _ := r.Oldest(); oldest != 3 {
+		t.Errorf("expected oldest 3, got %d", oldest)
+	}
+	if newest, _ := r.Newest(); newest != 5 {
+		t.Errorf("expected newest 5, got %d", newest)
+	}
+	if r.String() != "RingBuffer: [3] <-> [4] <-> [5]" {
+		t.Errorf("unexpected representation %q", r.String())
+	}
+}
+
+func TestRingBufferLatest(t *testing.T) {
+	r := NewRingBuffer[int](4)
+	r.Push(1)
+	r.Push(2)
+	if got := slices.Collect(r.Latest(5)); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+	for i := 3; i <= 6; i++ {
+		r.Push(i)
+	}
+	if got := slices.Collect(r.Latest(2)); !slices.Equal(got, []int{5, 6}) {
+		t.Errorf("expected [5 6], got %v", got)
+	}
+	if got := slices.Collect(r.Latest(0)); len(got) != 0 {
+		t.Errorf("expected nothing, got %v", got)
+	}
+}
+
+func TestRingBufferClear(t *testing.T) {
+	r := NewRingBuffer[*int](2)
+	value := 1
+	r.Push(&value)
+	r.Push(&value)
+	r.Clear()
+	if r.Len() != 0 || len(slices.Collect(r.All())) != 0 {
+		t.Fatalf("expected an empty buffer, got %d elements", r.Len())
+	}
+	for node := range r.ring.Size() {
+		n, _ := r.ring.Get(node)
+		if n.Value() != nil {
+			t.Error("expected Clear to release the stored values")
+		}
+	}
+	r.Push(&value)
+	if oldest, ok := r.Oldest(); !ok || oldest != &value {
+		t.Error("expected the buffer to be reusable after Clear")
+	}
+}
+
+func TestRingBufferDoesNotAllocate(t *testing.T) {
+	r := NewRingBuffer[int](8)
+	sum := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		r.Push(1)
+		v, _ := r.Oldest()
+		sum += v
+		for v := range r.All() {
+			sum += v
+		}
+		for v := range r.Latest(3) {
+			sum += v
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations, got %v", allocs)
+	}
+}
+
+func TestNewRingBufferPanicsOnInvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected NewRingBuffer to panic for capacity 0")
+		}
+	}()
+	NewRingBuffer[int](0)
+}
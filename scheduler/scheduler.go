@@ -0,0 +1,395 @@
+// Package scheduler simulates a round-robin CPU scheduler.
+//
+// The ready queue is a list.CircularSinglyLinkedList: the running process is
+// taken from its head and, when its time quantum expires, appended back at
+// its tail. Processes arrive at given times, may block on I/O after running
+// for a given amount of CPU time, and complete once their burst is used up.
+//
+// Time is simulated: each Step advances the clock by one tick, and Run steps
+// until every process has completed. The Result holds the Gantt chart of the
+// run and the per-process waiting and turnaround times.
+//
+// Ordering rules for a tick t:
+//
+//   - Processes whose I/O completes at t join the ready queue first, in the
+//     order they blocked.
+//   - Processes arriving at t join next, in the order they were given.
+//   - A process preempted at t joins last.
+//
+// Example:
+//
+//	sim, err := scheduler.New(scheduler.Config{Quantum: 2}, []scheduler.Process{
+//	    {ID: "P1", Arrival: 0, Burst: 5},
+//	    {ID: "P2", Arrival: 1, Burst: 3},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	result := sim.Run()
+//	fmt.Print(result.GanttChart())
+package scheduler
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"list"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Reported by New for an invalid configuration or process description.
+var ErrInvalidProcess = errors.New("scheduler: invalid process")
+
+// Configures a simulation.
+type Config struct {
+	// Maximum number of consecutive ticks a process runs before being
+	// preempted. Must be positive.
+	Quantum int
+}
+
+// Describes a process to schedule.
+type Process struct {
+	// Unique, non-empty name of the process, used in the Gantt chart and
+	// statistics. The empty string is reserved for idle slices.
+	ID string
+	// Tick at which the process becomes ready. Must not be negative.
+	Arrival int
+	// Total CPU time the process needs. Must be positive.
+	Burst int
+	// I/O requests, in increasing order of After.
+	IO []IO
+}
+
+// An I/O request made by a process.
+type IO struct {
+	// CPU time the process has used when it issues the request. Must be
+	// between 1 and Burst-1.
+	After int
+	// Number of ticks the process stays blocked. Must be positive.
+	Duration int
+}
+
+// A contiguous stretch of the Gantt chart.
+type Slice struct {
+	// The process that ran, or "" if the CPU was idle.
+	ID string
+	// First tick of the slice.
+	Start int
+	// Tick at which the slice ended, exclusive.
+	End int
+}
+
+// Scheduling statistics of a completed process.
+type Stats struct {
+	ID         string
+	Arrival    int
+	Burst      int
+	Completion int
+	// Completion - Arrival.
+	Turnaround int
+	// Time spent in the ready queue: Turnaround minus Burst and I/O time.
+	Waiting int
+	// Time between arrival and the first dispatch.
+	Response int
+}
+
+// The outcome of a simulation.
+type Result struct {
+	Gantt []Slice
+	// Statistics of every process, in the order they were given.
+	Processes         []Stats
+	AverageWaiting    float64
+	AverageTurnaround float64
+}
+
+// Simulation state of a process.
+type process struct {
+	Process
+	remaining  int
+	executed   int
+	nextIO     int
+	ioTime     int
+	readyAt    int
+	firstRun   int
+	completion int
+	started    bool
+}
+
+// A round-robin scheduler driven by a simulated clock.
+type Simulator struct {
+	config    Config
+	processes []*process
+	// Processes that have not arrived yet, by arrival time.
+	pending []*process
+	ready   *list.CircularSinglyLinkedList[*process]
+	blocked []*process
+	running *process
+	// Process whose quantum expired at the current tick, queued after the
+	// tick's arrivals.
+	preempted *process
+	// Ticks the running process has used of its current quantum.
+	used      int
+	completed int
+	now       int
+	gantt     []Slice
+}
+
+// Creates a simulator at tick 0.
+//
+// Parameters:
+//   - config: The scheduling parameters.
+//   - processes: The processes to schedule.
+//
+// Returns:
+//   - *Simulator: The simulator.
+//   - error: An error wrapping ErrInvalidProcess if the configuration or a
+//     process is invalid.
+//
+// Example:
+//
+//	sim, err := scheduler.New(scheduler.Config{Quantum: 4}, processes)
+func New(config Config, processes []Process) (*Simulator, error) {
+	if config.Quantum < 1 {
+		return nil, fmt.Errorf("%w: quantum %d must be positive", ErrInvalidProcess, config.Quantum)
+	}
+	s := &Simulator{
+		config: config,
+		ready:  list.NewCircularSinglyLinkedList[*process](),
+	}
+	seen := make(map[string]bool, len(processes))
+	for _, p := range processes {
+		if err := validate(p); err != nil {
+			return nil, err
+		}
+		if seen[p.ID] {
+			return nil, fmt.Errorf("%w: duplicate ID %q", ErrInvalidProcess, p.ID)
+		}
+		seen[p.ID] = true
+		s.processes = append(s.processes, &process{Process: p, remaining: p.Burst})
+	}
+	// Stable, so that processes arriving together keep their order.
+	s.pending = slices.Clone(s.processes)
+	slices.SortStableFunc(s.pending, func(a, b *process) int {
+		return cmp.Compare(a.Arrival, b.Arrival)
+	})
+	return s, nil
+}
+
+// Checks the fields of a single process.
+func validate(p Process) error {
+	switch {
+	case p.ID == "":
+		return fmt.Errorf("%w: empty ID, which is reserved for idle slices", ErrInvalidProcess)
+	case p.Arrival < 0:
+		return fmt.Errorf("%w: %q arrives at negative tick %d", ErrInvalidProcess, p.ID, p.Arrival)
+	case p.Burst < 1:
+		return fmt.Errorf("%w: %q has non-positive burst %d", ErrInvalidProcess, p.ID, p.Burst)
+	}
+	after := 0
+	for _, io := range p.IO {
+		if io.After <= after || io.After >= p.Burst || io.Duration < 1 {
+			return fmt.Errorf("%w: %q has invalid I/O %+v", ErrInvalidProcess, p.ID, io)
+		}
+		after = io.After
+	}
+	return nil
+}
+
+// Returns the current tick of the simulated clock.
+//
+// Returns:
+//   - int: The number of ticks simulated so far.
+//
+// Example:
+//
+//	fmt.Println(sim.Now())
+func (s *Simulator) Now() int {
+	return s.now
+}
+
+// Reports whether every process has completed.
+//
+// Returns:
+//   - bool: True once the simulation is over.
+//
+// Example:
+//
+//	for !sim.Done() {
+//	    sim.Step()
+//	}
+func (s *Simulator) Done() bool {
+	return s.completed == len(s.processes)
+}
+
+// Simulates one tick: updates the ready queue, dispatches a process if the
+// CPU is free, runs it for one tick and applies completion, I/O blocking and
+// preemption.
+//
+// Returns:
+//   - bool: False if the simulation was already over.
+//
+// Example:
+//
+//	for sim.Step() {
+//	    fmt.Println(sim.Now())
+//	}
+func (s *Simulator) Step() bool {
+	if s.Done() {
+		return false
+	}
+	s.admit()
+	if s.running == nil && !s.ready.IsEmpty() {
+		s.running = s.ready.Head().Value()
+		s.ready.RemoveFirst()
+		s.used = 0
+		if !s.running.started {
+			s.running.started = true
+			s.running.firstRun = s.now
+		}
+	}
+
+	id := ""
+	if s.running != nil {
+		id = s.running.ID
+		s.running.remaining--
+		s.running.executed++
+		s.used++
+	}
+	s.record(id)
+	s.now++
+
+	if p := s.running; p != nil {
+		switch {
+		case p.remaining == 0:
+			p.completion = s.now
+			s.completed++
+			s.running = nil
+		case p.nextIO < len(p.IO) && p.IO[p.nextIO].After == p.executed:
+			io := p.IO[p.nextIO]
+			p.nextIO++
+			p.ioTime += io.Duration
+			p.readyAt = s.now + io.Duration
+			s.blocked = append(s.blocked, p)
+			s.running = nil
+		case s.used == s.config.Quantum:
+			s.preempted = p
+			s.running = nil
+		}
+	}
+	return true
+}
+
+// Runs the simulation to completion.
+//
+// Returns:
+//   - Result: The Gantt chart and statistics.
+//
+// Example:
+//
+//	result := sim.Run()
+//	fmt.Printf("average waiting time: %.2f\n", result.AverageWaiting)
+func (s *Simulator) Run() Result {
+	for s.Step() {
+	}
+	return s.Result()
+}
+
+// Returns the Gantt chart so far and the statistics of the processes that
+// have completed.
+//
+// Returns:
+//   - Result: The current result. Averages cover completed processes only.
+//
+// Example:
+//
+//	partial := sim.Result()
+func (s *Simulator) Result() Result {
+	result := Result{Gantt: append([]Slice(nil), s.gantt...)}
+	waiting, turnaround := 0, 0
+	for _, p := range s.processes {
+		if p.remaining > 0 {
+			continue
+		}
+		stats := Stats{
+			ID:         p.ID,
+			Arrival:    p.Arrival,
+			Burst:      p.Burst,
+			Completion: p.completion,
+			Turnaround: p.completion - p.Arrival,
+			Response:   p.firstRun - p.Arrival,
+		}
+		stats.Waiting = stats.Turnaround - p.Burst - p.ioTime
+		waiting += stats.Waiting
+		turnaround += stats.Turnaround
+		result.Processes = append(result.Processes, stats)
+	}
+	if n := len(result.Processes); n > 0 {
+		result.AverageWaiting = float64(waiting) / float64(n)
+		result.AverageTurnaround = float64(turnaround) / float64(n)
+	}
+	return result
+}
+
+// Moves the processes that become ready at the current tick into the ready
+// queue, in the documented order.
+func (s *Simulator) admit() {
+	stillBlocked := s.blocked[:0]
+	for _, p := range s.blocked {
+		if p.readyAt == s.now {
+			s.ready.Append(p)
+		} else {
+			stillBlocked = append(stillBlocked, p)
+		}
+	}
+	s.blocked = stillBlocked
+	for len(s.pending) > 0 && s.pending[0].Arrival == s.now {
+		s.ready.Append(s.pending[0])
+		s.pending = s.pending[1:]
+	}
+	if s.preempted != nil {
+		s.ready.Append(s.preempted)
+		s.preempted = nil
+	}
+}
+
+// Extends the Gantt chart by one tick of id.
+func (s *Simulator) record(id string) {
+	if n := len(s.gantt); n > 0 && s.gantt[n-1].ID == id {
+		s.gantt[n-1].End++
+		return
+	}
+	s.gantt = append(s.gantt, Slice{ID: id, Start: s.now, End: s.now + 1})
+}
+
+// Renders the Gantt chart as two lines of text: the slices, labelled with
+// their process ID or "idle", and the ticks at which they start and end.
+//
+// Returns:
+//   - string: The chart, ending with a newline; empty if nothing ran.
+//
+// Example:
+//
+//	fmt.Print(result.GanttChart())
+//	// | P1 | P2 | P1 |
+//	// 0    2    4    5
+func (r Result) GanttChart() string {
+	if len(r.Gantt) == 0 {
+		return ""
+	}
+	var bars, ticks strings.Builder
+	for _, slice := range r.Gantt {
+		label := slice.ID
+		if label == "" {
+			label = "idle"
+		}
+		start := strconv.Itoa(slice.Start)
+		width := max(len(label)+3, len(start)+1)
+		bars.WriteString("| " + label + strings.Repeat(" ", width-len(label)-2))
+		ticks.WriteString(start + strings.Repeat(" ", width-len(start)))
+	}
+	bars.WriteString("|\n")
+	ticks.WriteString(strconv.Itoa(r.Gantt[len(r.Gantt)-1].End) + "\n")
+	return bars.String() + ticks.String()
+}
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRoundRobin(t *testing.T) {
+	sim, err := New(Config{Quantum: 2}, []Process{
+		{ID: "P1", Arrival: 0, Burst: 5},
+		{ID: "P2", Arrival: 1, Burst: 3},
+		{ID: "P3", Arrival: 2, Burst: 1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := sim.Run()
+	expected := []Slice{
+		{"P1", 0, 2}, {"P2", 2, 4}, {"P3", 4, 5}, {"P1", 5, 7}, {"P2", 7, 8}, {"P1", 8, 9},
+	}
+	if !slices.Equal(result.Gantt, expected) {
+		t.Fatalf("expected Gantt %v, got %v", expected, result.Gantt)
+	}
+	stats := []Stats{
+		{ID: "P1", Arrival: 0, Burst: 5, Completion: 9, Turnaround: 9, Waiting: 4, Response: 0},
+		{ID: "P2", Arrival: 1, Burst: 3, Completion: 8, Turnaround: 7, Waiting: 4, Response: 1},
+		{ID: "P3", Arrival: 2, Burst: 1, Completion: 5, Turnaround: 3, Waiting: 2, Response: 2},
+	}
+	if !slices.Equal(result.Processes, stats) {
+		t.Errorf("expected stats %v, got %v", stats, result.Processes)
+	}
+	if result.AverageWaiting != 10.0/3 || result.AverageTurnaround != 19.0/3 {
+		t.Errorf("unexpected averages %v and %v", result.AverageWaiting, result.AverageTurnaround)
+	}
+	if sim.Now() != 9 || sim.Step() {
+		t.Errorf("expected the clock to stop at 9, got %d", sim.Now())
+	}
+}
+
+func TestIOBlockingAndIdle(t *testing.T) {
+	sim, err := New(Config{Quantum: 4}, []Process{
+		{ID: "P1", Burst: 4, IO: []IO{{After: 2, Duration: 3}}},
+		{ID: "P2", Burst: 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := sim.Run()
+	expected := []Slice{{"P1", 0, 2}, {"P2", 2, 4}, {"", 4, 5}, {"P1", 5, 7}}
+	if !slices.Equal(result.Gantt, expected) {
+		t.Fatalf("expected Gantt %v, got %v", expected, result.Gantt)
+	}
+	if p1 := result.Processes[0]; p1.Turnaround != 7 || p1.Waiting != 0 {
+		t.Errorf("expected P1 turnaround 7 and waiting 0, got %+v", p1)
+	}
+	chart := "| P1 | P2 | idle | P1 |\n0    2    4      5    7\n"
+	if got := result.GanttChart(); got != chart {
+		t.Errorf("expected chart\n%s\ngot\n%s", chart, got)
+	}
+}
+
+func TestLateArrivalLeavesCPUIdle(t *testing.T) {
+	sim, _ := New(Config{Quantum: 3}, []Process{{ID: "A", Arrival: 2, Burst: 1}})
+	result := sim.Run()
+	if !slices.Equal(result.Gantt, []Slice{{"", 0, 2}, {"A", 2, 3}}) {
+		t.Errorf("unexpected Gantt %v", result.Gantt)
+	}
+}
+
+func TestStepExposesPartialResults(t *testing.T) {
+	sim, _ := New(Config{Quantum: 1}, []Process{{ID: "A", Burst: 2}, {ID: "B", Burst: 1}})
+	sim.Step()
+	sim.Step()
+	partial := sim.Result()
+	if len(partial.Processes) != 1 || partial.Processes[0].ID != "B" {
+		t.Errorf("expected only B to be complete, got %+v", partial.Processes)
+	}
+	if sim.Done() {
+		t.Error("expected the simulation to be running")
+	}
+}
+
+func TestNewRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		processes []Process
+	}{
+		{"zero quantum", Config{}, nil},
+		{"empty ID", Config{Quantum: 1}, []Process{{Burst: 1}}},
+		{"negative arrival", Config{Quantum: 1}, []Process{{ID: "A", Arrival: -1, Burst: 1}}},
+		{"zero burst", Config{Quantum: 1}, []Process{{ID: "A"}}},
+		{"duplicate ID", Config{Quantum: 1}, []Process{{ID: "A", Burst: 1}, {ID: "A", Burst: 1}}},
+		{"I/O at end of burst", Config{Quantum: 1}, []Process{{ID: "A", Burst: 2, IO: []IO{{After: 2, Duration: 1}}}}},
+		{"unordered I/O", Config{Quantum: 1}, []Process{{ID: "A", Burst: 5, IO: []IO{{After: 3, Duration: 1}, {After: 2, Duration: 1}}}}},
+	}
+	for _, test := range tests {
+		if _, err := New(test.config, test.processes); !errors.Is(err, ErrInvalidProcess) {
+			t.Errorf("%s: expected ErrInvalidProcess, got %v", test.name, err)
+		}
+	}
+}
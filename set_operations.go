@@ -0,0 +1,384 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "iter"
+
+// Walks a sorted list during a merge, either reading its values or, in
+// destructive mode, consuming its nodes.
+type sortedCursor[L LinearList[L, T], T comparable] struct {
+	list        L
+	destructive bool
+	next        func() (T, bool)
+	stop        func()
+	value       T
+	ok          bool
+}
+
+// Creates a cursor positioned at the first element of list.
+func newSortedCursor[L LinearList[L, T], T comparable](list L, destructive bool) *sortedCursor[L, T] {
+	c := &sortedCursor[L, T]{list: list, destructive: destructive}
+	if !destructive {
+		c.next, c.stop = iter.Pull(list.values())
+	}
+	c.load()
+	return c
+}
+
+// Loads the current element into value and ok.
+func (c *sortedCursor[L, T]) load() {
+	if c.destructive {
+		c.ok = !c.list.IsEmpty()
+		if c.ok {
+			c.value = c.list.first()
+		}
+		return
+	}
+	c.value, c.ok = c.next()
+}
+
+// Moves past the current element, adding it to out when keep is true.
+//
+// In destructive mode the node itself is moved into out, or dropped.
+func (c *sortedCursor[L, T]) advance(out L, keep bool) {
+	switch {
+	case c.destructive && keep:
+		c.list.moveFirstTo(out)
+	case c.destructive:
+		c.list.RemoveFirst()
+	case keep:
+		out.Append(c.value)
+	}
+	c.load()
+}
+
+// Releases the cursor. In destructive mode the remaining nodes are dropped so
+// the input is left empty.
+func (c *sortedCursor[L, T]) close() {
+	if c.stop != nil {
+		c.stop()
+	}
+	if c.destructive {
+		c.list.Clear()
+	}
+}
+
+// Selects which elements a merge keeps: those only in the first input, those
+// only in the second, and those in both.
+type mergeRule struct {
+	onlyA bool
+	onlyB bool
+	both  bool
+}
+
+// Merges two sorted lists in linear time according to rule.
+//
+// Equal elements are matched one-for-one, so duplicates are treated as a
+// multiset. When both inputs hold a matching element, the one from a is kept.
+func mergeSorted[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int, rule mergeRule, destructive bool) L {
+	out := a.newLike()
+	left := newSortedCursor(a, destructive)
+	defer left.close()
+	right := newSortedCursor(b, destructive)
+	defer right.close()
+	for left.ok && right.ok {
+		switch c := cmp(left.value, right.value); {
+		case c < 0:
+			left.advance(out, rule.onlyA)
+		case c > 0:
+			right.advance(out, rule.onlyB)
+		default:
+			left.advance(out, rule.both)
+			right.advance(out, false)
+		}
+	}
+	for left.ok {
+		left.advance(out, rule.onlyA)
+	}
+	for right.ok {
+		right.advance(out, rule.onlyB)
+	}
+	return out
+}
+
+// Returns a new sorted list holding every element present in a or b.
+//
+// Both inputs must be sorted according to cmp. Equal elements are matched
+// one-for-one, so an element appearing m times in a and n times in b appears
+// max(m, n) times in the result. The inputs are left unchanged.
+//
+// Parameters:
+//   - a: The first sorted list.
+//   - b: The second sorted list.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A new list of the same kind as the inputs.
+//
+// Example:
+//
+//	union := list.Union(a, b, cmp.Compare[int])
+func Union[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{onlyA: true, onlyB: true, both: true}, false)
+}
+
+// Returns a new sorted list holding every element present in both a and b.
+//
+// Both inputs must be sorted according to cmp. An element appearing m times in
+// a and n times in b appears min(m, n) times in the result. The inputs are left
+// unchanged.
+//
+// Parameters:
+//   - a: The first sorted list.
+//   - b: The second sorted list.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A new list of the same kind as the inputs.
+//
+// Example:
+//
+//	common := list.Intersection(a, b, cmp.Compare[int])
+func Intersection[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{both: true}, false)
+}
+
+// Returns a new sorted list holding the elements of a that are not in b.
+//
+// Both inputs must be sorted according to cmp. Each element of b cancels at
+// most one equal element of a. The inputs are left unchanged.
+//
+// Parameters:
+//   - a: The sorted list to subtract from.
+//   - b: The sorted list of elements to subtract.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A new list of the same kind as the inputs.
+//
+// Example:
+//
+//	rest := list.Difference(a, b, cmp.Compare[int])
+func Difference[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{onlyA: true}, false)
+}
+
+// Returns a new sorted list holding the elements present in exactly one of a
+// and b.
+//
+// Both inputs must be sorted according to cmp. Equal elements are matched
+// one-for-one and cancel each other. The inputs are left unchanged.
+//
+// Parameters:
+//   - a: The first sorted list.
+//   - b: The second sorted list.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A new list of the same kind as the inputs.
+//
+// Example:
+//
+//	either := list.SymmetricDifference(a, b, cmp.Compare[int])
+func SymmetricDifference[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{onlyA: true, onlyB: true}, false)
+}
+
+// Like Union, but builds the result by relinking the nodes of a and b instead
+// of allocating new ones. Both inputs are left empty.
+//
+// Parameters:
+//   - a: The first sorted list.
+//   - b: The second sorted list.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A list of the same kind as the inputs, made of their nodes.
+//
+// Example:
+//
+//	union := list.UnionDestructive(a, b, cmp.Compare[int])
+func UnionDestructive[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{onlyA: true, onlyB: true, both: true}, true)
+}
+
+// Like Intersection, but builds the result by relinking the nodes of a
+// instead of allocating new ones. Both inputs are left empty.
+//
+// Parameters:
+//   - a: The first sorted list.
+//   - b: The second sorted list.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A list of the same kind as the inputs, made of their nodes.
+//
+// Example:
+//
+//	common := list.IntersectionDestructive(a, b, cmp.Compare[int])
+func IntersectionDestructive[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{both: true}, true)
+}
+
+// Like Difference, but builds the result by relinking the nodes of a instead
+// of allocating new ones. Both inputs are left empty.
+//
+// Parameters:
+//   - a: The sorted list to subtract from.
+//   - b: The sorted list of elements to subtract.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A list of the same kind as the inputs, made of their nodes.
+//
+// Example:
+//
+//	rest := list.DifferenceDestructive(a, b, cmp.Compare[int])
+func DifferenceDestructive[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{onlyA: true}, true)
+}
+
+// Like SymmetricDifference, but builds the result by relinking the nodes of a
+// and b instead of allocating new ones. Both inputs are left empty.
+//
+// Parameters:
+//   - a: The first sorted list.
+//   - b: The second sorted list.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - L: A list of the same kind as the inputs, made of their nodes.
+//
+// Example:
+//
+//	either := list.SymmetricDifferenceDestructive(a, b, cmp.Compare[int])
+func SymmetricDifferenceDestructive[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) L {
+	return mergeSorted(a, b, cmp, mergeRule{onlyA: true, onlyB: true}, true)
+}
+
+// Reports whether every element of a is also in b.
+//
+// Both inputs must be sorted according to cmp. Duplicates are matched
+// one-for-one, so a holding an element twice requires b to hold it at least
+// twice.
+//
+// Parameters:
+//   - a: The candidate subset.
+//   - b: The candidate superset.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - bool: true if a is a subset of b, false otherwise.
+//
+// Example:
+//
+//	if list.IsSubset(a, b, cmp.Compare[int]) {
+//	    fmt.Println("a ⊆ b")
+//	}
+func IsSubset[L LinearList[L, T], T comparable](a, b L, cmp func(x, y T) int) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+	nextA, stopA := iter.Pull(a.values())
+	defer stopA()
+	nextB, stopB := iter.Pull(b.values())
+	defer stopB()
+	valueB, okB := nextB()
+	for valueA, okA := nextA(); okA; valueA, okA = nextA() {
+		for okB && cmp(valueB, valueA) < 0 {
+			valueB, okB = nextB()
+		}
+		if !okB || cmp(valueB, valueA) != 0 {
+			return false
+		}
+		valueB, okB = nextB()
+	}
+	return true
+}
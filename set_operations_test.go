@@ -0,0 +1,111 @@
+package list
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSetOperationsOnDoublyLinkedLists(t *testing.T) {
+	a := DoublyLinkedListOf(1, 2, 2, 4, 6)
+	b := DoublyLinkedListOf(2, 3, 4, 5)
+	cases := []struct {
+		name     string
+		got      *DoublyLinkedList[int]
+		expected []int
+	}{
+		{"Union", Union(a, b, cmp.Compare[int]), []int{1, 2, 2, 3, 4, 5, 6}},
+		{"Intersection", Intersection(a, b, cmp.Compare[int]), []int{2, 4}},
+		{"Difference", Difference(a, b, cmp.Compare[int]), []int{1, 2, 6}},
+		{"SymmetricDifference", SymmetricDifference(a, b, cmp.Compare[int]), []int{1, 2, 3, 5, 6}},
+	}
+	for _, c := range cases {
+		if !slices.Equal(c.got.ToSlice(), c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, c.got.ToSlice())
+		}
+	}
+	if !slices.Equal(a.ToSlice(), []int{1, 2, 2, 4, 6}) || !slices.Equal(b.ToSlice(), []int{2, 3, 4, 5}) {
+		t.Error("expected inputs to be left unchanged")
+	}
+}
+
+func TestSetOperationsOnSinglyLinkedLists(t *testing.T) {
+	descending := func(x, y string) int { return cmp.Compare(y, x) }
+	a := SinglyLinkedListOf("d", "c", "a")
+	b := SinglyLinkedListOf("c", "b")
+	union := Union(a, b, descending)
+	if !slices.Equal(union.ToSlice(), []string{"d", "c", "b", "a"}) {
+		t.Errorf("expected [d c b a], got %v", union.ToSlice())
+	}
+	if union.Tail().Value() != "a" {
+		t.Errorf("expected tail a, got %v", union.Tail().Value())
+	}
+	difference := Difference(a, b, descending)
+	if !slices.Equal(difference.ToSlice(), []string{"d", "a"}) {
+		t.Errorf("expected [d a], got %v", difference.ToSlice())
+	}
+}
+
+func TestSetOperationsDestructiveReuseNodes(t *testing.T) {
+	a := DoublyLinkedListOf(1, 3, 5)
+	b := DoublyLinkedListOf(2, 3, 4)
+	nodes := map[*DoublyLinkedNode[int]]bool{}
+	for current := a.Head(); current != nil; current = current.Next() {
+		nodes[current] = true
+	}
+	for current := b.Head(); current != nil; current = current.Next() {
+		nodes[current] = true
+	}
+	union := UnionDestructive(a, b, cmp.Compare[int])
+	if !a.IsEmpty() || !b.IsEmpty() {
+		t.Error("expected inputs to be left empty")
+	}
+	if !slices.Equal(union.ToSlice(), []int{1, 2, 3, 4, 5}) {
+		t.Errorf("expected [1 2 3 4 5], got %v", union.ToSlice())
+	}
+	backward := []int{}
+	for current := union.Tail(); current != nil; current = current.Prev() {
+		if !nodes[current] {
+			t.Errorf("expected node %v to come from an input", current.Value())
+		}
+		backward = append(backward, current.Value())
+	}
+	if !slices.Equal(backward, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("expected [5 4 3 2 1] walking backward, got %v", backward)
+	}
+
+	singlyA := SinglyLinkedListOf(1, 2, 3, 4)
+	singlyB := SinglyLinkedListOf(2, 4, 6)
+	head := singlyA.Head()
+	difference := DifferenceDestructive(singlyA, singlyB, cmp.Compare[int])
+	if difference.Head() != head || !slices.Equal(difference.ToSlice(), []int{1, 3}) {
+		t.Errorf("expected [1 3] reusing the head node, got %v", difference.ToSlice())
+	}
+	if difference.Tail().Next() != nil {
+		t.Error("expected tail to terminate the list")
+	}
+	intersection := IntersectionDestructive(SinglyLinkedListOf(1, 2), SinglyLinkedListOf(2, 3), cmp.Compare[int])
+	if !slices.Equal(intersection.ToSlice(), []int{2}) {
+		t.Errorf("expected [2], got %v", intersection.ToSlice())
+	}
+	symmetric := SymmetricDifferenceDestructive(SinglyLinkedListOf(1, 2), SinglyLinkedListOf(2, 3), cmp.Compare[int])
+	if !slices.Equal(symmetric.ToSlice(), []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", symmetric.ToSlice())
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	b := DoublyLinkedListOf(1, 2, 2, 3, 5)
+	if !IsSubset(DoublyLinkedListOf(2, 2, 5), b, cmp.Compare[int]) {
+		t.Error("expected [2 2 5] to be a subset")
+	}
+	if IsSubset(DoublyLinkedListOf(2, 2, 2), b, cmp.Compare[int]) {
+		t.Error("did not expect [2 2 2] to be a subset")
+	}
+	if IsSubset(DoublyLinkedListOf(4), b, cmp.Compare[int]) {
+		t.Error("did not expect [4] to be a subset")
+	}
+	if !IsSubset(NewSinglyLinkedList[int](), NewSinglyLinkedList[int](), cmp.Compare[int]) {
+		t.Error("expected the empty list to be a subset of itself")
+	}
+}
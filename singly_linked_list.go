@@ -89,7 +89,12 @@
 // Random access operations (Get, Set) have O(n) complexity due to linear traversal.
 package list
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+)
 
 // A generic singly linked list storing elements of type T.
 //
@@ -98,6 +103,8 @@ type SinglyLinkedList[T comparable] struct {
 	head *SinglyLinkedNode[T]
 	tail *SinglyLinkedNode[T]
 	size int
+	// Notified of mutations when set; see SetObserver.
+	observer Observer[T]
 }
 
 // Creates and returns a new empty singly linked list.
@@ -112,6 +119,42 @@ func NewSinglyLinkedList[T comparable]() *SinglyLinkedList[T] {
 	return &SinglyLinkedList[T]{}
 }
 
+// Creates and returns a new singly linked list holding the given
+// values, in order.
+//
+// Parameters:
+//   - values: The values to store.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.SinglyLinkedListOf(1, 2, 3)
+func SinglyLinkedListOf[T comparable](values ...T) *SinglyLinkedList[T] {
+	return SinglyLinkedListFromSlice(values)
+}
+
+// Creates and returns a new singly linked list holding the elements
+// of a slice.
+//
+// The slice is not retained.
+//
+// Parameters:
+//   - values: The slice to copy.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: Pointer to the new list.
+//
+// Example:
+//
+//	list := list.SinglyLinkedListFromSlice([]int{1, 2, 3})
+func SinglyLinkedListFromSlice[T comparable](values []T) *SinglyLinkedList[T] {
+	l := NewSinglyLinkedList[T]()
+	l.AppendAll(values...)
+	return l
+}
+
 // Returns the first node of the list.
 //
 // Returns:
@@ -168,9 +211,13 @@ func (l *SinglyLinkedList[T]) IsEmpty() bool {
 //
 //	list.Clear()
 func (l *SinglyLinkedList[T]) Clear() {
+	head, size := l.head, l.size
 	l.head = nil
 	l.tail = nil
 	l.size = 0
+	if l.observer != nil {
+		notifySinglyRemoved(l.observer, 0, head, size)
+	}
 }
 
 // Inserts a new element at the start of the list.
@@ -189,6 +236,9 @@ func (l *SinglyLinkedList[T]) Prepend(value T) {
 		l.tail = newNode
 	}
 	l.size++
+	if l.observer != nil {
+		l.observer.OnInsert(0, value)
+	}
 }
 
 // Adds a new element at the end of the list.
@@ -209,6 +259,103 @@ func (l *SinglyLinkedList[T]) Append(value T) {
 	}
 	l.tail = newNode
 	l.size++
+	if l.observer != nil {
+		l.observer.OnInsert(l.size-1, value)
+	}
+}
+
+// Inserts the given values at the beginning of the list, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.PrependAll(1, 2, 3)
+func (l *SinglyLinkedList[T]) PrependAll(values ...T) {
+	first, last := newSinglyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(nil, first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, 0, values)
+	}
+}
+
+// Inserts the given values at the end of the list, preserving their order,
+// linking them in a single pass.
+//
+// Parameters:
+//   - values: The values to insert.
+//
+// Example:
+//
+//	list.AppendAll(4, 5, 6)
+func (l *SinglyLinkedList[T]) AppendAll(values ...T) {
+	first, last := newSinglyChain(slices.Values(values))
+	if first == nil {
+		return
+	}
+	l.linkAfter(l.Tail(), first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, l.size-len(values), values)
+	}
+}
+
+// Inserts the given values starting at the specified index, preserving their
+// order, linking them in a single pass.
+//
+// Parameters:
+//   - index: Position at which to insert the first value (0-based).
+//   - values: The values to insert.
+//
+// Returns:
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := list.InsertAllAt(1, 7, 8, 9)
+func (l *SinglyLinkedList[T]) InsertAllAt(index int, values ...T) error {
+	if index < 0 || index > l.Size() {
+		return newIndexError("InsertAllAt", index, l.Size())
+	}
+	if index == l.Size() {
+		l.AppendAll(values...)
+		return nil
+	}
+	first, last := newSinglyChain(slices.Values(values))
+	if first == nil {
+		return nil
+	}
+	var prev *SinglyLinkedNode[T]
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	l.linkAfter(prev, first, last, len(values))
+	if l.observer != nil {
+		notifyInserted(l.observer, index, values)
+	}
+	return nil
+}
+
+// Splices the chain from first to last after prev, or at the front of the
+// list when prev is nil.
+func (l *SinglyLinkedList[T]) linkAfter(prev, first, last *SinglyLinkedNode[T], count int) {
+	var next *SinglyLinkedNode[T]
+	if prev == nil {
+		next = l.head
+		l.head = first
+	} else {
+		next = prev.Next()
+		prev.next = first
+	}
+	last.next = next
+	if next == nil {
+		l.tail = last
+	}
+	l.size += count
 }
 
 // Searches for the first node containing the specified value.
@@ -231,35 +378,175 @@ func (l *SinglyLinkedList[T]) Find(value T) *SinglyLinkedNode[T] {
 	return nil
 }
 
+// Returns the index of the first occurrence of the specified value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.IndexOf(7)
+func (l *SinglyLinkedList[T]) IndexOf(value T) int {
+	index := 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		if current.Value() == value {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Returns the index of the last occurrence of the specified value, scanning the whole list once.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: Zero-based index of the value, or -1 if it is not present.
+//
+// Example:
+//
+//	index := list.LastIndexOf(7)
+func (l *SinglyLinkedList[T]) LastIndexOf(value T) int {
+	last := -1
+	index := 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		if current.Value() == value {
+			last = index
+		}
+		index++
+	}
+	return last
+}
+
+// Searches for the first node whose value satisfies the predicate.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *SinglyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindFunc(func(v int) bool { return v > 10 })
+func (l *SinglyLinkedList[T]) FindFunc(predicate func(T) bool) *SinglyLinkedNode[T] {
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Value()) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Searches for the last node whose value satisfies the predicate, scanning the whole list once.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - *SinglyLinkedNode[T]: Pointer to the node if found, or nil otherwise.
+//
+// Example:
+//
+//	node := list.FindLast(func(v int) bool { return v > 10 })
+func (l *SinglyLinkedList[T]) FindLast(predicate func(T) bool) *SinglyLinkedNode[T] {
+	var last *SinglyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Value()) {
+			last = current
+		}
+	}
+	return last
+}
+
+// Collects every node whose value satisfies the predicate, in list order.
+//
+// Parameters:
+//   - predicate: Function reporting whether a value matches.
+//
+// Returns:
+//   - []*SinglyLinkedNode[T]: The matching nodes, or nil if there are none.
+//
+// Example:
+//
+//	nodes := list.FindAll(func(v int) bool { return v%2 == 0 })
+func (l *SinglyLinkedList[T]) FindAll(predicate func(T) bool) []*SinglyLinkedNode[T] {
+	var nodes []*SinglyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Value()) {
+			nodes = append(nodes, current)
+		}
+	}
+	return nodes
+}
+
+// Counts the occurrences of the specified value.
+//
+// Parameters:
+//   - value: The value to count.
+//
+// Returns:
+//   - int: Number of elements equal to value.
+//
+// Example:
+//
+//	n := list.Count(7)
+func (l *SinglyLinkedList[T]) Count(value T) int {
+	count := 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		if current.Value() == value {
+			count++
+		}
+	}
+	return count
+}
+
 // Removes the first element from the list.
 //
 // Does nothing if the list is empty.
 //
+// Returns:
+//   - bool: true if an element was removed; false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveFirst()
-func (l *SinglyLinkedList[T]) RemoveFirst() {
+func (l *SinglyLinkedList[T]) RemoveFirst() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
-	l.head = l.Head().Next()
+	node := l.Head()
+	l.head = node.Next()
 	if l.Head() == nil {
 		l.tail = nil
 	}
 	l.size--
+	if l.observer != nil {
+		l.observer.OnRemove(0, node.Value())
+	}
+	return true
 }
 
 // Removes the last element from the list.
 //
 // Does nothing if the list is empty.
 //
+// Returns:
+//   - bool: true if an element was removed; false if the list was empty.
+//
 // Example:
 //
 //	list.RemoveLast()
-func (l *SinglyLinkedList[T]) RemoveLast() {
+func (l *SinglyLinkedList[T]) RemoveLast() bool {
 	if l.IsEmpty() {
-		return
+		return false
 	}
+	node := l.Tail()
 	if l.Size() == 1 {
 		l.head = nil
 		l.tail = nil
@@ -272,6 +559,10 @@ func (l *SinglyLinkedList[T]) RemoveLast() {
 		l.tail = current
 	}
 	l.size--
+	if l.observer != nil {
+		l.observer.OnRemove(l.size, node.Value())
+	}
+	return true
 }
 
 // Deletes the first node found with the specified value.
@@ -281,17 +572,22 @@ func (l *SinglyLinkedList[T]) RemoveLast() {
 // Parameters:
 //   - value: Element to remove.
 //
+// Returns:
+//   - bool: true if an element was removed; false if the value was not found.
+//
 // Example:
 //
 //	list.Remove(3)
-func (l *SinglyLinkedList[T]) Remove(value T) {
+func (l *SinglyLinkedList[T]) Remove(value T) bool {
+	if l.observer != nil {
+		return l.removeObserved(value)
+	}
 	node := l.Find(value)
 	if node == nil {
-		return
+		return false
 	}
 	if node == l.Head() {
-		l.RemoveFirst()
-		return
+		return l.RemoveFirst()
 	}
 	prev := l.Head()
 	for prev.Next() != node {
@@ -302,6 +598,305 @@ func (l *SinglyLinkedList[T]) Remove(value T) {
 		l.tail = prev
 	}
 	l.size--
+	return true
+}
+
+// Removes and returns the element at the specified index.
+//
+// Parameters:
+//   - index: Zero-based index.
+//
+// Returns:
+//   - T: The removed value, or the zero value on error.
+//   - error: An *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	value, err := list.RemoveAt(1)
+func (l *SinglyLinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.Size() {
+		var zero T
+		return zero, newIndexError("RemoveAt", index, l.Size())
+	}
+	var prev *SinglyLinkedNode[T]
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	node := l.unlinkAfter(prev)
+	if l.observer != nil {
+		l.observer.OnRemove(index, node.Value())
+	}
+	return node.Value(), nil
+}
+
+// Deletes every node holding the specified value.
+//
+// Parameters:
+//   - value: Element to remove.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveAll(3)
+func (l *SinglyLinkedList[T]) RemoveAll(value T) int {
+	return l.RemoveIf(func(v T) bool { return v == value })
+}
+
+// Deletes every element for which the predicate returns true, in a single
+// pass.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be removed.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.RemoveIf(func(v int) bool { return v%2 == 0 })
+func (l *SinglyLinkedList[T]) RemoveIf(predicate func(T) bool) int {
+	removed, index := 0, 0
+	var prev *SinglyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Value()) {
+			l.unlinkAfter(prev)
+			removed++
+			if l.observer != nil {
+				l.observer.OnRemove(index, current.Value())
+			}
+		} else {
+			prev = current
+			index++
+		}
+	}
+	return removed
+}
+
+// Keeps only the elements for which the predicate returns true.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element should be kept.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Retain(func(v int) bool { return v > 0 })
+func (l *SinglyLinkedList[T]) Retain(predicate func(T) bool) int {
+	return l.RemoveIf(func(v T) bool { return !predicate(v) })
+}
+
+// Removes every element equal to an earlier one, keeping the first
+// occurrence, in a single pass that tracks seen values in a set.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Unique()
+func (l *SinglyLinkedList[T]) Unique() int {
+	seen := make(map[T]struct{}, l.Size())
+	return l.RemoveIf(func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+}
+
+// Removes every element that the equality function reports as equal to an
+// earlier one, keeping the first occurrence.
+//
+// Since no set can be built from an arbitrary equality, this runs in O(n*k)
+// where k is the number of distinct elements.
+//
+// Parameters:
+//   - equal: Function reporting whether two values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.UniqueFunc(strings.EqualFold)
+func (l *SinglyLinkedList[T]) UniqueFunc(equal func(a, b T) bool) int {
+	var kept []T
+	return l.RemoveIf(func(v T) bool {
+		for _, k := range kept {
+			if equal(k, v) {
+				return true
+			}
+		}
+		kept = append(kept, v)
+		return false
+	})
+}
+
+// Collapses runs of adjacent equal elements into a single element, like
+// slices.Compact.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.Compact()
+func (l *SinglyLinkedList[T]) Compact() int {
+	return l.CompactFunc(func(a, b T) bool { return a == b })
+}
+
+// Collapses runs of adjacent elements that the equality function reports as
+// equal, keeping the first element of each run, like slices.CompactFunc.
+//
+// Parameters:
+//   - equal: Function reporting whether two adjacent values are duplicates.
+//
+// Returns:
+//   - int: Number of elements removed.
+//
+// Example:
+//
+//	removed := list.CompactFunc(strings.EqualFold)
+func (l *SinglyLinkedList[T]) CompactFunc(equal func(a, b T) bool) int {
+	var last T
+	started := false
+	return l.RemoveIf(func(v T) bool {
+		if started && equal(last, v) {
+			return true
+		}
+		last, started = v, true
+		return false
+	})
+}
+
+// Splits the list into the elements that satisfy the predicate and those that
+// do not, preserving their relative order.
+//
+// The nodes are relinked into the two results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - predicate: Function reporting whether an element belongs to the first list.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: The elements for which predicate returned true.
+//   - *SinglyLinkedList[T]: The remaining elements.
+//
+// Example:
+//
+//	evens, odds := list.Partition(func(v int) bool { return v%2 == 0 })
+func (l *SinglyLinkedList[T]) Partition(predicate func(T) bool) (*SinglyLinkedList[T], *SinglyLinkedList[T]) {
+	matched, rest := NewSinglyLinkedList[T](), NewSinglyLinkedList[T]()
+	for !l.IsEmpty() {
+		if predicate(l.first()) {
+			l.moveFirstTo(matched)
+		} else {
+			l.moveFirstTo(rest)
+		}
+	}
+	return matched, rest
+}
+
+// Splits the list into the elements less than, equal to and greater than the
+// pivot, preserving their relative order within each part.
+//
+// The nodes are relinked into the three results rather than copied, so the
+// receiver is left empty.
+//
+// Parameters:
+//   - pivot: The value to partition around.
+//   - cmp: Comparator returning a negative number, zero or a positive number.
+//
+// Returns:
+//   - *SinglyLinkedList[T]: The elements less than pivot.
+//   - *SinglyLinkedList[T]: The elements equal to pivot.
+//   - *SinglyLinkedList[T]: The elements greater than pivot.
+//
+// Example:
+//
+//	less, equal, greater := list.PartitionAround(5, cmp.Compare[int])
+func (l *SinglyLinkedList[T]) PartitionAround(pivot T, cmp func(x, y T) int) (*SinglyLinkedList[T], *SinglyLinkedList[T], *SinglyLinkedList[T]) {
+	less, equal, greater := NewSinglyLinkedList[T](), NewSinglyLinkedList[T](), NewSinglyLinkedList[T]()
+	for !l.IsEmpty() {
+		switch c := cmp(l.first(), pivot); {
+		case c < 0:
+			l.moveFirstTo(less)
+		case c > 0:
+			l.moveFirstTo(greater)
+		default:
+			l.moveFirstTo(equal)
+		}
+	}
+	return less, equal, greater
+}
+
+// Deletes the elements in the half-open range [from, to).
+//
+// Parameters:
+//   - from: Index of the first element to remove.
+//   - to: Index one past the last element to remove.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.RemoveRange(1, 3)
+func (l *SinglyLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange("RemoveRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	var prev *SinglyLinkedNode[T]
+	last := l.Head()
+	if from > 0 {
+		prev = l.nodeAt(from - 1)
+		last = prev.Next()
+	}
+	first := last
+	for range to - from - 1 {
+		last = last.Next()
+	}
+	if prev == nil {
+		l.head = last.Next()
+	} else {
+		prev.next = last.Next()
+	}
+	if last == l.Tail() {
+		l.tail = prev
+	}
+	l.size -= to - from
+	if l.observer != nil {
+		notifySinglyRemoved(l.observer, from, first, to-from)
+	}
+	return nil
+}
+
+// Unlinks the node following prev, or the head when prev is nil, and returns
+// it.
+//
+// The node to unlink must exist.
+func (l *SinglyLinkedList[T]) unlinkAfter(prev *SinglyLinkedNode[T]) *SinglyLinkedNode[T] {
+	var node *SinglyLinkedNode[T]
+	if prev == nil {
+		node = l.head
+		l.head = node.Next()
+	} else {
+		node = prev.Next()
+		prev.next = node.Next()
+	}
+	if node == l.tail {
+		l.tail = prev
+	}
+	l.size--
+	return node
 }
 
 // Returns a string representation of the list.
@@ -313,20 +908,70 @@ func (l *SinglyLinkedList[T]) Remove(value T) {
 //
 //	fmt.Println(list.String())
 func (l *SinglyLinkedList[T]) String() string {
-	if l.IsEmpty() {
-		return "SinglyLinkedList: []"
+	return listString(l.layout())
+}
+
+// Implements fmt.Formatter.
+//
+// The verbs %v and %s print the compact form returned by String, %+v also
+// reports the size and the head and tail values, and %#v prints Go syntax that
+// rebuilds the list, such as list.SinglyLinkedListOf[int](1, 2). Any other verb
+// is applied to each element, along with the # flag. A precision (or, failing
+// that, a width) limits how many elements are printed, replacing the rest with
+// an ellipsis.
+//
+// Parameters:
+//   - f: The formatter state.
+//   - verb: The formatting verb.
+//
+// Example:
+//
+//	fmt.Printf("%.2v\n", list) // SinglyLinkedList: [0] -> [1] -> ...
+func (l *SinglyLinkedList[T]) Format(f fmt.State, verb rune) {
+	formatList(f, verb, l.layout())
+}
+
+// Streams the compact representation of the list to w without building the
+// whole string in memory.
+//
+// Parameters:
+//   - w: The destination writer.
+//
+// Returns:
+//   - int64: Number of bytes written.
+//   - error: The first error returned by w, if any.
+//
+// Example:
+//
+//	_, err := list.WriteTo(os.Stdout)
+func (l *SinglyLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeList(w, l.layout(), defaultFormatOptions)
+}
+
+// Describes the list for the shared formatting helpers.
+func (l *SinglyLinkedList[T]) layout() listLayout[T] {
+	layout := listLayout[T]{
+		name:      "SinglyLinkedList",
+		separator: " -> ",
+		size:      l.Size(),
+		values:    l.values(),
 	}
-	result := "SinglyLinkedList: "
-	current := l.Head()
-	for {
-		result += fmt.Sprintf("[%v]", current.Value())
-		if !current.HasNext() {
-			break
+	if !l.IsEmpty() {
+		layout.head = l.Head().Value()
+		layout.tail = l.Tail().Value()
+	}
+	return layout
+}
+
+// Returns an iterator over the values of the list, from head to tail.
+func (l *SinglyLinkedList[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.Head(); current != nil; current = current.Next() {
+			if !yield(current.Value()) {
+				return
+			}
 		}
-		result += " -> "
-		current = current.Next()
 	}
-	return result
 }
 
 // Inserts a new element at the specified index.
@@ -336,14 +981,14 @@ func (l *SinglyLinkedList[T]) String() string {
 //   - value: Element to insert.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	list.InsertAt(1, 42)
 func (l *SinglyLinkedList[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > l.Size() {
-		return fmt.Errorf("index %d out of bounds", index)
+		return newIndexError("InsertAt", index, l.Size())
 	}
 	if index == 0 {
 		l.Prepend(value)
@@ -361,6 +1006,9 @@ func (l *SinglyLinkedList[T]) InsertAt(index int, value T) error {
 	newNode.next = current.Next()
 	current.next = newNode
 	l.size++
+	if l.observer != nil {
+		l.observer.OnInsert(index, value)
+	}
 	return nil
 }
 
@@ -371,20 +1019,27 @@ func (l *SinglyLinkedList[T]) InsertAt(index int, value T) error {
 //
 // Returns:
 //   - *SinglyLinkedNode[T]: Pointer to the node.
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	node, err := list.Get(0)
 func (l *SinglyLinkedList[T]) Get(index int) (*SinglyLinkedNode[T], error) {
 	if index < 0 || index >= l.Size() {
-		return nil, fmt.Errorf("index %d out of bounds", index)
+		return nil, newIndexError("Get", index, l.Size())
 	}
+	return l.nodeAt(index), nil
+}
+
+// Returns the node at the given index.
+//
+// The index must be within bounds.
+func (l *SinglyLinkedList[T]) nodeAt(index int) *SinglyLinkedNode[T] {
 	current := l.Head()
 	for range index {
 		current = current.Next()
 	}
-	return current, nil
+	return current
 }
 
 // Updates the value of the node at the specified index.
@@ -394,17 +1049,21 @@ func (l *SinglyLinkedList[T]) Get(index int) (*SinglyLinkedNode[T], error) {
 //   - value: New value to set.
 //
 // Returns:
-//   - error: If index is out of bounds.
+//   - error: An *IndexError if index is out of bounds.
 //
 // Example:
 //
 //	list.Set(1, 99)
 func (l *SinglyLinkedList[T]) Set(index int, value T) error {
-	node, err := l.Get(index)
-	if err != nil {
-		return fmt.Errorf("index %d out of bounds", index)
+	if index < 0 || index >= l.Size() {
+		return newIndexError("Set", index, l.Size())
 	}
+	node := l.nodeAt(index)
+	old := node.Value()
 	node.SetValue(value)
+	if l.observer != nil {
+		l.observer.OnSet(index, old, value)
+	}
 	return nil
 }
 
@@ -424,6 +1083,91 @@ func (l *SinglyLinkedList[T]) Reverse() {
 		current = next
 	}
 	l.head = prev
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the order of the elements in the half-open range [from, to) by
+// relinking their nodes in place.
+//
+// Parameters:
+//   - from: Index of the first element to reverse.
+//   - to: Index one past the last element to reverse.
+//
+// Returns:
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	err := list.ReverseRange(1, 4)
+func (l *SinglyLinkedList[T]) ReverseRange(from, to int) error {
+	if err := checkRange("ReverseRange", from, to, l.Size()); err != nil {
+		return err
+	}
+	if to-from < 2 {
+		return nil
+	}
+	var prev *SinglyLinkedNode[T]
+	if from > 0 {
+		prev = l.nodeAt(from - 1)
+	}
+	l.reverseAfter(prev, to-from)
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+	return nil
+}
+
+// Reverses every consecutive group of k elements in place. A trailing group
+// with fewer than k elements is reversed as well.
+//
+// Parameters:
+//   - k: The size of each group. ReverseGroups panics if k is less than 1.
+//
+// Example:
+//
+//	list.ReverseGroups(2) // [1 2 3 4 5] becomes [2 1 4 3 5]
+func (l *SinglyLinkedList[T]) ReverseGroups(k int) {
+	if k < 1 {
+		panic("list: group size cannot be less than 1")
+	}
+	var prev *SinglyLinkedNode[T]
+	for remaining := l.Size(); remaining > 0; remaining -= k {
+		prev = l.reverseAfter(prev, min(k, remaining))
+	}
+	if l.observer != nil {
+		l.observer.OnReorder()
+	}
+}
+
+// Reverses the count nodes following prev, or starting at the head when prev
+// is nil, and returns the node that now ends the reversed segment.
+//
+// count must be at least 1 and no greater than the number of nodes available.
+func (l *SinglyLinkedList[T]) reverseAfter(prev *SinglyLinkedNode[T], count int) *SinglyLinkedNode[T] {
+	first := l.Head()
+	if prev != nil {
+		first = prev.Next()
+	}
+	var reversed *SinglyLinkedNode[T]
+	current := first
+	for range count {
+		next := current.Next()
+		current.next = reversed
+		reversed = current
+		current = next
+	}
+	first.next = current
+	if prev == nil {
+		l.head = reversed
+	} else {
+		prev.next = reversed
+	}
+	if current == nil {
+		l.tail = first
+	}
+	return first
 }
 
 // Returns true if the list contains the specified value.
@@ -458,3 +1202,33 @@ func (l *SinglyLinkedList[T]) ForEach(action func(T)) {
 		action(current.Value())
 	}
 }
+
+// Returns an iterator over the elements of the list, from head to tail.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range list.All() {
+//	    fmt.Println(v)
+//	}
+func (l *SinglyLinkedList[T]) All() iter.Seq[T] {
+	return l.values()
+}
+
+// Returns a slice containing all elements of the list.
+//
+// Returns:
+//   - []T: Slice of all elements, from head to tail.
+//
+// Example:
+//
+//	slice := list.ToSlice()
+func (l *SinglyLinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, l.Size())
+	for value := range l.values() {
+		result = append(result, value)
+	}
+	return result
+}
@@ -89,6 +89,8 @@
 // Random access operations (Get, Set) have O(n) complexity due to linear traversal.
 package list
 
+import "iter"
+
 // Represents a node in a singly linked list, storing a value
 // of type T and a pointer to the next node.
 //
@@ -175,3 +177,25 @@ func (n *SinglyLinkedNode[T]) Next() *SinglyLinkedNode[T] {
 func (n *SinglyLinkedNode[T]) HasNext() bool {
 	return n.next != nil
 }
+
+// Links the given values into a new chain of singly linked nodes.
+//
+// Parameters:
+//   - values: The values to store, in order.
+//
+// Returns:
+//   - *SinglyLinkedNode[T]: The first node of the chain, or nil if values is empty.
+//   - *SinglyLinkedNode[T]: The last node of the chain, or nil if values is empty.
+func newSinglyChain[T comparable](values iter.Seq[T]) (*SinglyLinkedNode[T], *SinglyLinkedNode[T]) {
+	var first, last *SinglyLinkedNode[T]
+	for value := range values {
+		node := NewSinglyLinkedNode(value)
+		if first == nil {
+			first = node
+		} else {
+			last.next = node
+		}
+		last = node
+	}
+	return first, last
+}
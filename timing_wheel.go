@@ -0,0 +1,427 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Provides the current time to a TimingWheel. Tests can supply a fake clock
+// to drive the wheel without real time.
+type Clock interface {
+	Now() time.Time
+}
+
+// The Clock used when WheelConfig.Clock is nil.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Configures a TimingWheel.
+type WheelConfig struct {
+	// Duration of one tick, the resolution of the wheel. Must be positive.
+	Tick time.Duration
+	// Number of slots on each wheel. Must be at least 2.
+	Slots int
+	// Source of the current time for Poll. Defaults to the system clock.
+	Clock Clock
+}
+
+// A pending callback scheduled on a TimingWheel.
+type Timer struct {
+	// Absolute tick at which the timer fires.
+	expiry   int64
+	callback func()
+	// The slot holding the timer, or nil once it fired or was cancelled.
+	slot *DoublyLinkedList[*Timer]
+	node *DoublyLinkedNode[*Timer]
+}
+
+// A hashed hierarchical timing wheel with O(1) scheduling and cancellation.
+//
+// Each wheel is a CircularDoublyLinkedList of slots, and each slot is a
+// DoublyLinkedList of the timers due in it. Timers beyond the span of a wheel
+// go to an overflow wheel whose slots each cover a full turn of the wheel
+// below; overflow wheels are added as needed, and their timers move down a
+// level every time their slot comes round.
+//
+// Time only moves when Advance or Poll is called, which makes the wheel fully
+// testable with a fake Clock. All methods are safe for concurrent use.
+//
+// Example:
+//
+//	wheel := list.NewTimingWheel(list.WheelConfig{Tick: 10 * time.Millisecond, Slots: 512})
+//	timer := wheel.Schedule(time.Second, func() { log.Println("timed out") })
+//	wheel.Cancel(timer)
+type TimingWheel struct {
+	mu     sync.Mutex
+	tick   time.Duration
+	slots  int
+	clock  Clock
+	start  time.Time
+	now    int64
+	size   int
+	levels []*wheelLevel
+}
+
+// One wheel of the hierarchy.
+type wheelLevel struct {
+	// Number of ticks covered by each slot.
+	interval int64
+	ring     *CircularDoublyLinkedList[*DoublyLinkedList[*Timer]]
+	// The nodes of ring, indexed for O(1) access by slot number.
+	nodes []*DoublyLinkedNode[*DoublyLinkedList[*Timer]]
+	// The slot of the current time.
+	cursor *DoublyLinkedNode[*DoublyLinkedList[*Timer]]
+}
+
+// Creates an empty timing wheel whose time starts at the clock's current
+// time.
+//
+// Parameters:
+//   - config: The tick duration, number of slots and clock.
+//
+// Returns:
+//   - *TimingWheel: Pointer to the new wheel.
+//
+// Example:
+//
+//	wheel := list.NewTimingWheel(list.WheelConfig{Tick: time.Millisecond, Slots: 256})
+func NewTimingWheel(config WheelConfig) *TimingWheel {
+	if config.Tick <= 0 {
+		panic("list: timing wheel tick must be positive")
+	}
+	if config.Slots < 2 {
+		panic("list: timing wheel needs at least 2 slots")
+	}
+	if config.Clock == nil {
+		config.Clock = systemClock{}
+	}
+	w := &TimingWheel{
+		tick:  config.Tick,
+		slots: config.Slots,
+		clock: config.Clock,
+		start: config.Clock.Now(),
+	}
+	w.addLevel()
+	return w
+}
+
+// Schedules callback to run once delay has elapsed on the wheel.
+//
+// The delay is measured from the wheel's current time and rounded up to a
+// whole number of ticks, with a minimum of one, so the callback never runs
+// during Schedule.
+//
+// Parameters:
+//   - delay: Time until the callback runs.
+//   - callback: The function to run, from Advance or Poll.
+//
+// Returns:
+//   - *Timer: The scheduled timer, for use with Cancel.
+//
+// Example:
+//
+//	timer := wheel.Schedule(5*time.Second, func() { conn.Close() })
+func (w *TimingWheel) Schedule(delay time.Duration, callback func()) *Timer {
+	// Rounded up without adding to delay, which could overflow.
+	ticks := int64(delay / w.tick)
+	if delay%w.tick > 0 {
+		ticks++
+	}
+	ticks = max(ticks, 1)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	expiry := int64(math.MaxInt64)
+	if ticks < math.MaxInt64-w.now {
+		expiry = w.now + ticks
+	}
+	timer := &Timer{expiry: expiry, callback: callback}
+	timer.node = NewDoublyLinkedNode(timer)
+	w.insert(timer)
+	w.size++
+	return timer
+}
+
+// Cancels a pending timer.
+//
+// Parameters:
+//   - timer: A timer returned by Schedule.
+//
+// Returns:
+//   - bool: true if the timer was pending, false if it already fired or was
+//     cancelled.
+//
+// Example:
+//
+//	if wheel.Cancel(timer) {
+//	    log.Println("cancelled before the timeout")
+//	}
+func (w *TimingWheel) Cancel(timer *Timer) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if timer.slot == nil {
+		return false
+	}
+	timer.slot.unlink(timer.node)
+	timer.slot = nil
+	w.size--
+	return true
+}
+
+// Moves the wheel forward by the given number of ticks and runs the callbacks
+// of the timers that expired, in order of expiry.
+//
+// The callbacks run after the wheel has advanced, without holding its lock,
+// so they may schedule and cancel timers.
+//
+// Parameters:
+//   - ticks: The number of ticks to advance. Advance panics if it is
+//     negative.
+//
+// Returns:
+//   - int: The number of callbacks run.
+//
+// Example:
+//
+//	wheel.Advance(1)
+func (w *TimingWheel) Advance(ticks int) int {
+	if ticks < 0 {
+		panic("list: cannot advance a timing wheel by a negative number of ticks")
+	}
+	w.mu.Lock()
+	expired := w.advance(w.now + int64(ticks))
+	w.mu.Unlock()
+	return runTimers(expired)
+}
+
+// Advances the wheel to the current time of its clock, like Advance.
+//
+// Returns:
+//   - int: The number of callbacks run.
+//
+// Example:
+//
+//	for range time.Tick(10 * time.Millisecond) {
+//	    wheel.Poll()
+//	}
+func (w *TimingWheel) Poll() int {
+	w.mu.Lock()
+	expired := w.advance(int64(w.clock.Now().Sub(w.start) / w.tick))
+	w.mu.Unlock()
+	return runTimers(expired)
+}
+
+// Returns the number of ticks the wheel has advanced since it was created.
+//
+// Returns:
+//   - int64: The current tick.
+func (w *TimingWheel) Ticks() int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.now
+}
+
+// Returns the wheel's current time: its start time plus the ticks it has
+// advanced.
+//
+// Returns:
+//   - time.Time: The current time of the wheel.
+func (w *TimingWheel) Now() time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.start.Add(time.Duration(w.now) * w.tick)
+}
+
+// Returns the number of pending timers.
+//
+// Returns:
+//   - int: The number of timers that neither fired nor were cancelled.
+func (w *TimingWheel) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.size
+}
+
+// Adds an overflow wheel on top of the hierarchy, with its cursor on the slot
+// of the current time.
+func (w *TimingWheel) addLevel() {
+	interval := int64(1)
+	if n := len(w.levels); n > 0 {
+		interval = w.levels[n-1].interval * int64(w.slots)
+	}
+	level := &wheelLevel{
+		interval: interval,
+		ring:     NewCircularDoublyLinkedList[*DoublyLinkedList[*Timer]](),
+		nodes:    make([]*DoublyLinkedNode[*DoublyLinkedList[*Timer]], w.slots),
+	}
+	for i := range w.slots {
+		level.ring.Append(NewDoublyLinkedList[*Timer]())
+		level.nodes[i] = level.ring.Tail()
+	}
+	level.cursor = level.nodes[w.now/interval%int64(w.slots)]
+	w.levels = append(w.levels, level)
+}
+
+// Links a timer into the lowest wheel whose span covers its expiry, adding
+// overflow wheels as needed. The timer must expire after the current tick.
+func (w *TimingWheel) insert(timer *Timer) {
+	slots := int64(w.slots)
+	for i := 0; ; i++ {
+		if i == len(w.levels) {
+			w.addLevel()
+		}
+		level := w.levels[i]
+		// The top wheel covers any expiry once a turn would overflow.
+		last := level.interval > math.MaxInt64/slots
+		if last || timer.expiry-(w.now-w.now%level.interval) < level.interval*slots {
+			slot := level.nodes[timer.expiry/level.interval%slots].Value()
+			slot.linkAfter(slot.Tail(), timer.node, timer.node, 1)
+			timer.slot = slot
+			return
+		}
+	}
+}
+
+// Runs the callbacks of the expired timers and returns how many ran.
+func runTimers(expired []*Timer) int {
+	for _, timer := range expired {
+		timer.callback()
+	}
+	return len(expired)
+}
+
+// Moves the current tick forward to target, if it lies ahead, cascading the
+// timers of every overflow slot that comes round, and returns the expired
+// timers in order of expiry.
+//
+// A slot is emptied before its timers are inserted again, so a timer that
+// goes back into the same slot, as on the top wheel, waits for the next turn.
+func (w *TimingWheel) advance(target int64) []*Timer {
+	var expired []*Timer
+	for w.now < target {
+		if w.size == 0 {
+			w.now = target
+			for _, level := range w.levels {
+				level.cursor = level.nodes[w.now/level.interval%int64(w.slots)]
+			}
+			break
+		}
+		w.now++
+		for i := len(w.levels) - 1; i >= 0; i-- {
+			level := w.levels[i]
+			if w.now%level.interval != 0 {
+				continue
+			}
+			level.cursor = level.cursor.Next()
+			slot := level.cursor.Value()
+			cascaded := make([]*Timer, 0, slot.Size())
+			for !slot.IsEmpty() {
+				timer := slot.Head().Value()
+				slot.unlink(timer.node)
+				timer.slot = nil
+				cascaded = append(cascaded, timer)
+			}
+			for _, timer := range cascaded {
+				if timer.expiry <= w.now {
+					expired = append(expired, timer)
+					w.size--
+				} else {
+					w.insert(timer)
+				}
+			}
+		}
+	}
+	return expired
+}
@@ -0,0 +1,251 @@
+package list
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A Clock that only moves when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestWheel(slots int) *TimingWheel {
+	return NewTimingWheel(WheelConfig{
+		Tick:  time.Millisecond,
+		Slots: slots,
+		Clock: &fakeClock{now: time.Unix(0, 0)},
+	})
+}
+
+func TestTimingWheelFiresOnTime(t *testing.T) {
+	wheel := newTestWheel(4)
+	fired := make(map[string]int64)
+	schedule := func(name string, delay time.Duration) {
+		wheel.Schedule(delay, func() { fired[name] = wheel.Ticks() })
+	}
+	schedule("3ms", 3*time.Millisecond)
+	schedule("1ms", time.Millisecond)
+	schedule("rounded up", 2500*time.Microsecond)
+	schedule("zero", 0)
+	schedule("overflow", 10*time.Millisecond)
+	schedule("two overflows", 70*time.Millisecond)
+	if len(wheel.levels) != 4 {
+		t.Errorf("expected 4 wheels for a 70 tick delay on 4 slots, got %d", len(wheel.levels))
+	}
+	for range 80 {
+		wheel.Advance(1)
+	}
+	want := map[string]int64{"3ms": 3, "1ms": 1, "rounded up": 3, "zero": 1, "overflow": 10, "two overflows": 70}
+	for name, tick := range want {
+		if fired[name] != tick {
+			t.Errorf("%s: expected to fire at tick %d, fired at %d", name, tick, fired[name])
+		}
+	}
+	if wheel.Len() != 0 {
+		t.Errorf("expected no pending timers, got %d", wheel.Len())
+	}
+}
+
+func TestTimingWheelRandomDelays(t *testing.T) {
+	wheel := newTestWheel(8)
+	rng := rand.New(rand.NewPCG(1, 2))
+	wrong := 0
+	for range 2000 {
+		delay := 1 + rng.Int64N(5000)
+		wheel.Schedule(time.Duration(delay)*time.Millisecond, func() {
+			if wheel.Ticks() != delay {
+				wrong++
+			}
+		})
+	}
+	fired := 0
+	for range 5000 {
+		fired += wheel.Advance(1)
+	}
+	if fired != 2000 || wrong != 0 {
+		t.Errorf("expected 2000 timers to fire on time, got %d with %d late or early", fired, wrong)
+	}
+}
+
+func TestTimingWheelAdvanceRunsInOrder(t *testing.T) {
+	wheel := newTestWheel(4)
+	var order []int
+	for _, delay := range []int{40, 5, 17, 2, 64, 9} {
+		wheel.Schedule(time.Duration(delay)*time.Millisecond, func() { order = append(order, delay) })
+	}
+	if ran := wheel.Advance(100); ran != 6 {
+		t.Errorf("expected 6 callbacks, got %d", ran)
+	}
+	if !slices.Equal(order, []int{2, 5, 9, 17, 40, 64}) {
+		t.Errorf("expected callbacks in order of expiry, got %v", order)
+	}
+}
+
+func TestTimingWheelCancel(t *testing.T) {
+	wheel := newTestWheel(4)
+	ran := 0
+	near := wheel.Schedule(2*time.Millisecond, func() { ran++ })
+	far := wheel.Schedule(50*time.Millisecond, func() { ran++ })
+	kept := wheel.Schedule(3*time.Millisecond, func() { ran++ })
+	if !wheel.Cancel(near) || !wheel.Cancel(far) {
+		t.Fatal("expected pending timers to be cancelled")
+	}
+	if wheel.Cancel(near) {
+		t.Error("expected a second Cancel to report false")
+	}
+	if wheel.Len() != 1 {
+		t.Errorf("expected 1 pending timer, got %d", wheel.Len())
+	}
+	wheel.Advance(60)
+	if ran != 1 {
+		t.Errorf("expected only the kept timer to run, got %d callbacks", ran)
+	}
+	if wheel.Cancel(kept) {
+		t.Error("expected Cancel to report false for a timer that fired")
+	}
+}
+
+func TestTimingWheelPoll(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(100, 0)}
+	wheel := NewTimingWheel(WheelConfig{Tick: 10 * time.Millisecond, Slots: 16, Clock: clock})
+	ran := 0
+	wheel.Schedule(15*time.Millisecond, func() { ran++ })
+	clock.Add(19 * time.Millisecond)
+	if wheel.Poll(); ran != 0 || wheel.Ticks() != 1 {
+		t.Errorf("expected one tick without firing, got tick %d and %d callbacks", wheel.Ticks(), ran)
+	}
+	clock.Add(time.Millisecond)
+	if fired := wheel.Poll(); fired != 1 || ran != 1 {
+		t.Errorf("expected the timer to fire after 20ms, got %d callbacks", ran)
+	}
+	if want := time.Unix(100, 0).Add(20 * time.Millisecond); !wheel.Now().Equal(want) {
+		t.Errorf("expected the wheel time to be %v, got %v", want, wheel.Now())
+	}
+	if wheel.Poll() != 0 || wheel.Ticks() != 2 {
+		t.Error("expected Poll without clock movement to do nothing")
+	}
+}
+
+func TestTimingWheelIdleJump(t *testing.T) {
+	wheel := newTestWheel(4)
+	wheel.Advance(1_000_003)
+	var firedAt int64
+	wheel.Schedule(70*time.Millisecond, func() { firedAt = wheel.Ticks() })
+	for range 80 {
+		wheel.Advance(1)
+	}
+	if firedAt != 1_000_073 {
+		t.Errorf("expected the timer to fire at tick 1000073, got %d", firedAt)
+	}
+}
+
+func TestTimingWheelHugeDelays(t *testing.T) {
+	wheel := newTestWheel(8)
+	ran := 0
+	wheel.Schedule(time.Duration(math.MaxInt64), func() { ran++ })
+	wheel.Schedule(time.Duration(math.MaxInt64)-time.Millisecond+1, func() { ran++ })
+	for range 1000 {
+		wheel.Advance(1)
+	}
+	if ran != 0 || wheel.Len() != 2 {
+		t.Errorf("expected huge delays to stay pending, got %d callbacks and %d pending", ran, wheel.Len())
+	}
+
+	late := newTestWheel(8)
+	late.Advance(math.MaxInt64 - 5)
+	timer := late.Schedule(10*time.Millisecond, func() { ran++ })
+	if timer.expiry != math.MaxInt64 {
+		t.Errorf("expected the expiry to be capped at the last tick, got %d", timer.expiry)
+	}
+	if late.Advance(4); ran != 0 {
+		t.Error("expected the capped timer not to fire early")
+	}
+	if late.Advance(1); ran != 1 {
+		t.Error("expected the capped timer to fire on the last tick")
+	}
+}
+
+func TestTimingWheelCallbackReschedules(t *testing.T) {
+	wheel := newTestWheel(4)
+	var ticks []int64
+	var tick func()
+	tick = func() {
+		ticks = append(ticks, wheel.Ticks())
+		if len(ticks) < 3 {
+			wheel.Schedule(5*time.Millisecond, tick)
+		}
+	}
+	wheel.Schedule(5*time.Millisecond, tick)
+	for range 20 {
+		wheel.Advance(1)
+	}
+	if !slices.Equal(ticks, []int64{5, 10, 15}) {
+		t.Errorf("expected the callback to reschedule itself at [5 10 15], got %v", ticks)
+	}
+}
+
+func TestTimingWheelConcurrentUse(t *testing.T) {
+	wheel := newTestWheel(16)
+	var mu sync.Mutex
+	ran := 0
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				timer := wheel.Schedule(time.Duration(1+i%50)*time.Millisecond, func() {
+					mu.Lock()
+					ran++
+					mu.Unlock()
+				})
+				if (g+i)%2 == 0 {
+					wheel.Cancel(timer)
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			wheel.Advance(1)
+		}
+	}()
+	wg.Wait()
+	wheel.Advance(100)
+	if ran != 800 || wheel.Len() != 0 {
+		t.Errorf("expected 800 callbacks and no pending timers, got %d and %d", ran, wheel.Len())
+	}
+}
+
+func TestNewTimingWheelPanicsOnInvalidConfig(t *testing.T) {
+	for _, config := range []WheelConfig{{Tick: 0, Slots: 8}, {Tick: time.Millisecond, Slots: 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for %+v", config)
+				}
+			}()
+			NewTimingWheel(config)
+		}()
+	}
+}
@@ -0,0 +1,400 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "iter"
+
+// A live window over a range of nodes of a DoublyLinkedList or a
+// CircularDoublyLinkedList, optionally in reverse order.
+//
+// A view does not copy anything: reads go to the parent's nodes and Set
+// updates them in place, so the change is visible through the parent. Any
+// structural change to the parent (insertion, removal, reordering or Clear)
+// invalidates the view, after which its operations report ErrViewInvalidated.
+type View[T comparable] struct {
+	first         *DoublyLinkedNode[T]
+	last          *DoublyLinkedNode[T]
+	size          int
+	reversed      bool
+	parentVersion *int
+	version       int
+}
+
+// Creates a view over the count nodes starting at first, walking backward
+// when reversed is true.
+func newView[T comparable](first *DoublyLinkedNode[T], count int, reversed bool, parentVersion *int) *View[T] {
+	v := &View[T]{first: first, size: count, reversed: reversed, parentVersion: parentVersion, version: *parentVersion}
+	if count > 0 {
+		v.last = first
+		for range count - 1 {
+			v.last = v.step(v.last)
+		}
+	}
+	return v
+}
+
+// Returns a live view of the elements in the half-open range [from, to).
+//
+// Parameters:
+//   - from: Index of the first element of the view.
+//   - to: Index one past the last element of the view.
+//
+// Returns:
+//   - *View[T]: The view.
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	middle, err := list.Sublist(1, 4)
+func (l *DoublyLinkedList[T]) Sublist(from, to int) (*View[T], error) {
+	if err := checkRange("Sublist", from, to, l.Size()); err != nil {
+		return nil, err
+	}
+	var first *DoublyLinkedNode[T]
+	if from < to {
+		first = l.nodeAt(from)
+	}
+	return newView(first, to-from, false, &l.version), nil
+}
+
+// Returns a live view of the whole list in reverse order.
+//
+// Returns:
+//   - *View[T]: The view.
+//
+// Example:
+//
+//	for v := range list.Reversed().All() {
+//	    fmt.Println(v)
+//	}
+func (l *DoublyLinkedList[T]) Reversed() *View[T] {
+	return newView(l.Tail(), l.Size(), true, &l.version)
+}
+
+// Returns a live view of the elements in the half-open range [from, to).
+//
+// The range does not wrap around the ring.
+//
+// Parameters:
+//   - from: Index of the first element of the view.
+//   - to: Index one past the last element of the view.
+//
+// Returns:
+//   - *View[T]: The view.
+//   - error: An *IndexError if the range is out of bounds or from > to.
+//
+// Example:
+//
+//	middle, err := list.Sublist(1, 4)
+func (l *CircularDoublyLinkedList[T]) Sublist(from, to int) (*View[T], error) {
+	if err := checkRange("Sublist", from, to, l.Size()); err != nil {
+		return nil, err
+	}
+	var first *DoublyLinkedNode[T]
+	if from < to {
+		first = l.nodeAt(from)
+	}
+	return newView(first, to-from, false, &l.version), nil
+}
+
+// Returns a live view of the whole list in reverse order, starting at the
+// tail.
+//
+// Returns:
+//   - *View[T]: The view.
+//
+// Example:
+//
+//	for v := range list.Reversed().All() {
+//	    fmt.Println(v)
+//	}
+func (l *CircularDoublyLinkedList[T]) Reversed() *View[T] {
+	return newView(l.Tail(), l.Size(), true, &l.version)
+}
+
+// Reports whether the view is still usable.
+//
+// Returns:
+//   - error: ErrViewInvalidated if the parent changed structurally since the
+//     view was created, nil otherwise.
+//
+// Example:
+//
+//	if err := view.Err(); err != nil {
+//	    view, _ = list.Sublist(1, 4)
+//	}
+func (v *View[T]) Err() error {
+	if *v.parentVersion != v.version {
+		return ErrViewInvalidated
+	}
+	return nil
+}
+
+// Returns the number of elements in the view.
+//
+// Returns:
+//   - int: Number of elements, as of when the view was created.
+//
+// Example:
+//
+//	fmt.Println(view.Len())
+func (v *View[T]) Len() int {
+	return v.size
+}
+
+// Retrieves the parent's node at the specified index of the view.
+//
+// Parameters:
+//   - index: Position within the view (0-based).
+//
+// Returns:
+//   - *DoublyLinkedNode[T]: Pointer to the node.
+//   - error: ErrViewInvalidated, or an *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	node, err := view.Get(0)
+func (v *View[T]) Get(index int) (*DoublyLinkedNode[T], error) {
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= v.size {
+		return nil, newIndexError("Get", index, v.size)
+	}
+	return v.nodeAt(index), nil
+}
+
+// Updates the value at the specified index of the view, and therefore in the
+// parent list.
+//
+// Parameters:
+//   - index: Position within the view (0-based).
+//   - value: New value to set.
+//
+// Returns:
+//   - error: ErrViewInvalidated, or an *IndexError if index is out of bounds.
+//
+// Example:
+//
+//	err := view.Set(0, 42)
+func (v *View[T]) Set(index int, value T) error {
+	if err := v.Err(); err != nil {
+		return err
+	}
+	if index < 0 || index >= v.size {
+		return newIndexError("Set", index, v.size)
+	}
+	v.nodeAt(index).SetValue(value)
+	return nil
+}
+
+// Returns a live view of a range of this view.
+//
+// Parameters:
+//   - from: Index of the first element, relative to this view.
+//   - to: Index one past the last element, relative to this view.
+//
+// Returns:
+//   - *View[T]: The narrower view, sharing this view's parent.
+//   - error: ErrViewInvalidated, or an *IndexError if the range is out of bounds.
+//
+// Example:
+//
+//	inner, err := view.Sublist(1, 2)
+func (v *View[T]) Sublist(from, to int) (*View[T], error) {
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	if err := checkRange("Sublist", from, to, v.size); err != nil {
+		return nil, err
+	}
+	var first *DoublyLinkedNode[T]
+	if from < to {
+		first = v.nodeAt(from)
+	}
+	return newView(first, to-from, v.reversed, v.parentVersion), nil
+}
+
+// Returns a live view of the same elements in the opposite order.
+//
+// Returns:
+//   - *View[T]: The reversed view. It is invalid if this view is.
+//
+// Example:
+//
+//	backward := view.Reversed()
+func (v *View[T]) Reversed() *View[T] {
+	return &View[T]{
+		first:         v.last,
+		last:          v.first,
+		size:          v.size,
+		reversed:      !v.reversed,
+		parentVersion: v.parentVersion,
+		version:       v.version,
+	}
+}
+
+// Returns an iterator over the elements of the view, in view order.
+//
+// An invalidated view yields nothing; check Err to tell that apart from an
+// empty view. Iteration stops if the parent changes structurally midway.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over the values.
+//
+// Example:
+//
+//	for v := range view.All() {
+//	    fmt.Println(v)
+//	}
+func (v *View[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := v.first
+		for range v.size {
+			if v.Err() != nil || !yield(current.Value()) {
+				return
+			}
+			current = v.step(current)
+		}
+	}
+}
+
+// Returns a slice containing the elements of the view.
+//
+// Returns:
+//   - []T: Slice of the elements, in view order.
+//   - error: ErrViewInvalidated if the view is no longer usable.
+//
+// Example:
+//
+//	values, err := view.ToSlice()
+func (v *View[T]) ToSlice() ([]T, error) {
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	result := make([]T, 0, v.size)
+	for value := range v.All() {
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// Returns the node following node in view order.
+func (v *View[T]) step(node *DoublyLinkedNode[T]) *DoublyLinkedNode[T] {
+	if v.reversed {
+		return node.Prev()
+	}
+	return node.Next()
+}
+
+// Returns the node preceding node in view order.
+func (v *View[T]) stepBack(node *DoublyLinkedNode[T]) *DoublyLinkedNode[T] {
+	if v.reversed {
+		return node.Next()
+	}
+	return node.Prev()
+}
+
+// Returns the node at the given index of the view, walking from whichever end
+// is closer. The index must be within bounds.
+func (v *View[T]) nodeAt(index int) *DoublyLinkedNode[T] {
+	if index < v.size/2 {
+		current := v.first
+		for range index {
+			current = v.step(current)
+		}
+		return current
+	}
+	current := v.last
+	for range v.size - 1 - index {
+		current = v.stepBack(current)
+	}
+	return current
+}
@@ -0,0 +1,120 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestDoublyLinkedListSublist(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3, 4, 5)
+	view, err := list.Sublist(1, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if view.Len() != 3 {
+		t.Errorf("expected length 3, got %d", view.Len())
+	}
+	if got, _ := view.ToSlice(); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Errorf("expected [2 3 4], got %v", got)
+	}
+	for i, want := range []int{2, 3, 4} {
+		node, err := view.Get(i)
+		if err != nil || node.Value() != want {
+			t.Errorf("Get(%d): expected %d, got %v (%v)", i, want, node, err)
+		}
+	}
+	if err := view.Set(0, 20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 20, 3, 4, 5}) {
+		t.Errorf("expected Set to reach the parent, got %v", list.ToSlice())
+	}
+	if _, err := view.Get(3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange, got %v", err)
+	}
+	if _, err := list.Sublist(3, 2); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("expected ErrIndexOutOfRange for from > to, got %v", err)
+	}
+	empty, err := list.Sublist(2, 2)
+	if err != nil || empty.Len() != 0 {
+		t.Errorf("expected an empty view, got %v (%v)", empty, err)
+	}
+}
+
+func TestDoublyLinkedListReversedView(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3, 4)
+	view := list.Reversed()
+	if got := slices.Collect(view.All()); !slices.Equal(got, []int{4, 3, 2, 1}) {
+		t.Errorf("expected [4 3 2 1], got %v", got)
+	}
+	inner, err := view.Sublist(1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := inner.ToSlice(); !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("expected [3 2], got %v", got)
+	}
+	if got, _ := inner.Reversed().ToSlice(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("expected [2 3], got %v", got)
+	}
+	if err := view.Set(3, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Head().Value() != 10 {
+		t.Errorf("expected head 10, got %d", list.Head().Value())
+	}
+}
+
+func TestViewInvalidatedByStructuralChange(t *testing.T) {
+	list := DoublyLinkedListOf(1, 2, 3)
+	view, _ := list.Sublist(0, 2)
+	list.Set(0, 5)
+	if err := view.Err(); err != nil {
+		t.Errorf("expected Set to keep the view valid, got %v", err)
+	}
+	list.Append(4)
+	if !errors.Is(view.Err(), ErrViewInvalidated) {
+		t.Errorf("expected ErrViewInvalidated, got %v", view.Err())
+	}
+	if _, err := view.Get(0); !errors.Is(err, ErrViewInvalidated) {
+		t.Errorf("expected ErrViewInvalidated from Get, got %v", err)
+	}
+	if err := view.Set(0, 1); !errors.Is(err, ErrViewInvalidated) {
+		t.Errorf("expected ErrViewInvalidated from Set, got %v", err)
+	}
+	if _, err := view.ToSlice(); !errors.Is(err, ErrViewInvalidated) {
+		t.Errorf("expected ErrViewInvalidated from ToSlice, got %v", err)
+	}
+	if got := slices.Collect(view.All()); len(got) != 0 {
+		t.Errorf("expected an invalid view to yield nothing, got %v", got)
+	}
+
+	reversed := list.Reversed()
+	list.Reverse()
+	if !errors.Is(reversed.Err(), ErrViewInvalidated) {
+		t.Errorf("expected Reverse to invalidate the view, got %v", reversed.Err())
+	}
+}
+
+func TestCircularDoublyLinkedListViews(t *testing.T) {
+	list := CircularDoublyLinkedListOf(1, 2, 3, 4, 5)
+	view, err := list.Sublist(2, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := view.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("expected [3 4 5], got %v", got)
+	}
+	if got, _ := list.Reversed().ToSlice(); !slices.Equal(got, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("expected [5 4 3 2 1], got %v", got)
+	}
+	view.Set(2, 50)
+	if list.Tail().Value() != 50 {
+		t.Errorf("expected tail 50, got %d", list.Tail().Value())
+	}
+	list.RemoveFirst()
+	if !errors.Is(view.Err(), ErrViewInvalidated) {
+		t.Errorf("expected ErrViewInvalidated, got %v", view.Err())
+	}
+}
@@ -0,0 +1,278 @@
+// Package list provides generic linked list data structures and nodes in Go.
+//
+// It includes implementations for singly linked lists, doubly linked lists, and
+// their circular variants, as well as the corresponding node types. All lists are
+// generic and work with any comparable type T.
+//
+// The package offers a rich set of operations such as insertion, deletion, search,
+// traversal, reversal, and random access.
+//
+// Both linear and circular lists support iteration that respects their structural
+// properties.
+//
+// ## Provided Types:
+//
+//   - SinglyLinkedList[T]:
+//     A linear singly linked list where each node points to the next node.
+//   - CircularSinglyLinkedList[T]:
+//     A circular singly linked list where the last node points back to the first
+//     node.
+//   - DoublyLinkedList[T]:
+//     A linear doubly linked list where each node points to both the next and
+//     previous nodes.
+//   - CircularDoublyLinkedList[T]:
+//     A circular doubly linked list where the last node points to the first node
+//     and vice versa.
+//   - SinglyLinkedNode[T]:
+//     A node for singly linked lists, storing a value and a pointer to the next
+//     node.
+//   - DoublyLinkedNode[T]:
+//     A node for doubly linked lists, storing a value and pointers to both the
+//     next and previous nodes.
+//
+// ## Features:
+//
+//   - Generic (works with any comparable type T)
+//   - Insertion at head, tail, or arbitrary index
+//   - Removal by value, head, or tail
+//   - Search and containment checks
+//   - Traversal and ForEach iteration
+//   - Reversal of list order
+//   - Conversion to slices for interoperability
+//
+// ## Examples:
+//
+// SinglyLinkedList:
+//
+//	list := list.NewSinglyLinkedList[int]()
+//	list.Append(1)
+//	list.Prepend(0)
+//	fmt.Println(list) // SinglyLinkedList: [0] -> [1]
+//	list.Reverse()
+//	fmt.Println(list) // SinglyLinkedList: [1] -> [0]
+//
+// CircularSinglyLinkedList:
+//
+//	clist := list.NewCircularSinglyLinkedList[int]()
+//	clist.Append(1)
+//	clist.Append(2)
+//	clist.Append(3)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [2] -> [3]
+//	clist.Remove(2)
+//	fmt.Println(clist) // CircularSinglyLinkedList: [1] -> [3]
+//
+// DoublyLinkedList:
+//
+//	dlist := list.NewDoublyLinkedList[int]()
+//	dlist.Append(10)
+//	dlist.Prepend(5)
+//	dlist.InsertAt(1, 7)
+//	fmt.Println(dlist) // DoublyLinkedList: [5] ↔ [7] ↔ [10]
+//	dlist.Reverse()
+//	fmt.Println(dlist) // DoublyLinkedList: [10] ↔ [7] ↔ [5]
+//
+// CircularDoublyLinkedList:
+//
+//	cdlist := list.NewCircularDoublyLinkedList[int]()
+//	cdlist.Append(10)
+//	cdlist.Append(20)
+//	cdlist.Prepend(5)
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [5] <-> [10] <-> [20]
+//	cdlist.Reverse()
+//	fmt.Println(cdlist) // CircularDoublyLinkedList: [20] <-> [10] <-> [5]
+//
+// ## Notes:
+//
+// All lists are dynamic in size and support O(1) insertion and removal at the ends
+// (head/tail).
+//
+// Random access operations (Get, Set) have O(n) complexity due to linear traversal.
+package list
+
+import "iter"
+
+// Holds one element from each of two lists, as produced by Zip.
+type Pair[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// Pairs up the elements of two lists, stopping at the end of the shorter one.
+//
+// The inputs may be of different kinds and are left unchanged.
+//
+// Parameters:
+//   - a: The list providing the first element of each pair.
+//   - b: The list providing the second element of each pair.
+//
+// Returns:
+//   - *DoublyLinkedList[Pair[A, B]]: A new list of pairs.
+//
+// Example:
+//
+//	pairs := list.Zip(names, ages) // [{ann 31}] ↔ [{bob 42}]
+func Zip[LA AnyList[A], LB AnyList[B], A, B comparable](a LA, b LB) *DoublyLinkedList[Pair[A, B]] {
+	result := NewDoublyLinkedList[Pair[A, B]]()
+	for first, second := range ZipSeq(a.values(), b.values()) {
+		result.Append(Pair[A, B]{First: first, Second: second})
+	}
+	return result
+}
+
+// Pairs up the elements of two lists until both are exhausted, filling in for
+// the shorter one with the given defaults.
+//
+// Parameters:
+//   - a: The list providing the first element of each pair.
+//   - b: The list providing the second element of each pair.
+//   - fillA: Value used once a is exhausted.
+//   - fillB: Value used once b is exhausted.
+//
+// Returns:
+//   - *DoublyLinkedList[Pair[A, B]]: A new list of pairs.
+//
+// Example:
+//
+//	pairs := list.ZipLongest(names, ages, "?", 0)
+func ZipLongest[LA AnyList[A], LB AnyList[B], A, B comparable](a LA, b LB, fillA A, fillB B) *DoublyLinkedList[Pair[A, B]] {
+	result := NewDoublyLinkedList[Pair[A, B]]()
+	for first, second := range ZipLongestSeq(a.values(), b.values(), fillA, fillB) {
+		result.Append(Pair[A, B]{First: first, Second: second})
+	}
+	return result
+}
+
+// Returns an iterator pairing up the elements of two sequences, stopping at the
+// end of the shorter one.
+//
+// Parameters:
+//   - a: The sequence providing the first element of each pair.
+//   - b: The sequence providing the second element of each pair.
+//
+// Returns:
+//   - iter.Seq2[A, B]: An iterator over the pairs.
+//
+// Example:
+//
+//	for name, age := range list.ZipSeq(names.All(), ages.All()) {
+//	    fmt.Println(name, age)
+//	}
+func ZipSeq[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+		for first := range a {
+			second, ok := nextB()
+			if !ok || !yield(first, second) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator pairing up the elements of two sequences until both are
+// exhausted, filling in for the shorter one with the given defaults.
+//
+// Parameters:
+//   - a: The sequence providing the first element of each pair.
+//   - b: The sequence providing the second element of each pair.
+//   - fillA: Value used once a is exhausted.
+//   - fillB: Value used once b is exhausted.
+//
+// Returns:
+//   - iter.Seq2[A, B]: An iterator over the pairs.
+//
+// Example:
+//
+//	for name, age := range list.ZipLongestSeq(names.All(), ages.All(), "?", 0) {
+//	    fmt.Println(name, age)
+//	}
+func ZipLongestSeq[A, B any](a iter.Seq[A], b iter.Seq[B], fillA A, fillB B) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextA, stopA := iter.Pull(a)
+		defer stopA()
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+		for {
+			first, okA := nextA()
+			second, okB := nextB()
+			if !okA && !okB {
+				return
+			}
+			if !okA {
+				first = fillA
+			}
+			if !okB {
+				second = fillB
+			}
+			if !yield(first, second) {
+				return
+			}
+		}
+	}
+}
+
+// Merges lists by taking one element from each in turn, continuing with the
+// remaining lists once the shorter ones are exhausted.
+//
+// The inputs are left unchanged.
+//
+// Parameters:
+//   - lists: The lists to interleave, all of the same kind.
+//
+// Returns:
+//   - *DoublyLinkedList[T]: A new list holding every element.
+//
+// Example:
+//
+//	mixed := list.Interleave(a, b) // a0, b0, a1, b1, ...
+func Interleave[L AnyList[T], T comparable](lists ...L) *DoublyLinkedList[T] {
+	seqs := make([]iter.Seq[T], len(lists))
+	for i, l := range lists {
+		seqs[i] = l.values()
+	}
+	result := NewDoublyLinkedList[T]()
+	for value := range InterleaveSeq(seqs...) {
+		result.Append(value)
+	}
+	return result
+}
+
+// Returns an iterator taking one element from each sequence in turn, skipping
+// sequences once they are exhausted.
+//
+// Parameters:
+//   - seqs: The sequences to interleave.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator over every element.
+//
+// Example:
+//
+//	for v := range list.InterleaveSeq(a.All(), b.All()) {
+//	    fmt.Println(v)
+//	}
+func InterleaveSeq[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), 0, len(seqs))
+		for _, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			nexts = append(nexts, next)
+		}
+		for len(nexts) > 0 {
+			active := nexts[:0]
+			for _, next := range nexts {
+				value, ok := next()
+				if !ok {
+					continue
+				}
+				if !yield(value) {
+					return
+				}
+				active = append(active, next)
+			}
+			nexts = active
+		}
+	}
+}
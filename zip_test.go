@@ -0,0 +1,63 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	names := SinglyLinkedListOf("ann", "bob", "cy")
+	ages := CircularDoublyLinkedListOf(31, 42)
+	pairs := Zip(names, ages)
+	expected := []Pair[string, int]{{"ann", 31}, {"bob", 42}}
+	if !slices.Equal(pairs.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, pairs.ToSlice())
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	names := DoublyLinkedListOf("ann", "bob", "cy")
+	ages := CircularSinglyLinkedListOf(31)
+	pairs := ZipLongest(names, ages, "?", -1)
+	expected := []Pair[string, int]{{"ann", 31}, {"bob", -1}, {"cy", -1}}
+	if !slices.Equal(pairs.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, pairs.ToSlice())
+	}
+	pairs = ZipLongest(NewSinglyLinkedList[string](), ages, "?", -1)
+	expected = []Pair[string, int]{{"?", 31}}
+	if !slices.Equal(pairs.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, pairs.ToSlice())
+	}
+}
+
+func TestZipSeqStopsEarly(t *testing.T) {
+	a := DoublyLinkedListOf(1, 2, 3)
+	b := DoublyLinkedListOf("x", "y", "z")
+	count := 0
+	for range ZipSeq(a.All(), b.All()) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("expected 2 iterations, got %d", count)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	a := SinglyLinkedListOf(1, 4, 6, 7)
+	b := SinglyLinkedListOf(2, 5)
+	c := SinglyLinkedListOf(3)
+	mixed := Interleave(a, b, c)
+	if !slices.Equal(mixed.ToSlice(), []int{1, 2, 3, 4, 5, 6, 7}) {
+		t.Errorf("expected [1 2 3 4 5 6 7], got %v", mixed.ToSlice())
+	}
+	if a.Size() != 4 {
+		t.Error("expected inputs to be left unchanged")
+	}
+	got := slices.Collect(InterleaveSeq(a.All(), b.All()))
+	if !slices.Equal(got, []int{1, 2, 4, 5, 6, 7}) {
+		t.Errorf("expected [1 2 4 5 6 7], got %v", got)
+	}
+}